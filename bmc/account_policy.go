@@ -0,0 +1,176 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// AccountPolicy is the BMC local account password, lockout and session policy.
+//
+// A nil field is a setting the BMC does not report, SetAccountPolicy only changes the settings that are not nil,
+// leaving the rest of the policy as is.
+type AccountPolicy struct {
+	// MinPasswordLength is the minimum number of characters in a password.
+	MinPasswordLength *int
+	// PasswordComplexity indicates passwords require upper case, numeric and symbol characters,
+	// this is only supported through vendor extensions.
+	PasswordComplexity *bool
+	// LockoutThreshold is the number of failed logins after which an account is locked, zero disables lockout.
+	LockoutThreshold *int
+	// LockoutDuration is the period an account remains locked.
+	LockoutDuration *time.Duration
+	// LockoutCounterResetAfter is the period after which the failed login count is reset.
+	LockoutCounterResetAfter *time.Duration
+	// SessionTimeout is the idle period after which a session is closed.
+	SessionTimeout *time.Duration
+}
+
+// complete returns true when the policy includes all settings.
+func (p AccountPolicy) complete() bool {
+	return p.MinPasswordLength != nil && p.PasswordComplexity != nil && p.LockoutThreshold != nil &&
+		p.LockoutDuration != nil && p.LockoutCounterResetAfter != nil && p.SessionTimeout != nil
+}
+
+// AccountPolicyManager gets and sets the BMC account policy.
+type AccountPolicyManager interface {
+	GetAccountPolicy(ctx context.Context) (policy AccountPolicy, err error)
+	SetAccountPolicy(ctx context.Context, policy AccountPolicy) (err error)
+}
+
+type accountPolicyManagerProvider struct {
+	name string
+	AccountPolicyManager
+}
+
+// getAccountPolicy returns the account policy from the first provider that reports all settings,
+// the policy of the first successful provider is returned when none do.
+//
+// Generic providers are registered before the vendor providers and do not report the vendor specific settings,
+// the vendor providers are asked as well so that a missing setting is not taken as disabled.
+func getAccountPolicy(ctx context.Context, generic []accountPolicyManagerProvider) (policy AccountPolicy, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	var partial *AccountPolicy
+	var partialProvider string
+
+	for _, elem := range generic {
+		if elem.AccountPolicyManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return policy, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			policy, vErr := elem.GetAccountPolicy(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+
+			if !policy.complete() {
+				if partial == nil {
+					partial, partialProvider = &policy, elem.name
+				}
+				continue
+			}
+
+			metadata.SuccessfulProvider = elem.name
+			return policy, metadata, nil
+		}
+	}
+
+	if partial != nil {
+		metadata.SuccessfulProvider = partialProvider
+		return *partial, metadata, nil
+	}
+
+	return policy, metadata, multierror.Append(err, errors.New("failure to get account policy"))
+}
+
+// setAccountPolicy sets the account policy with the first successful provider.
+func setAccountPolicy(ctx context.Context, policy AccountPolicy, generic []accountPolicyManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.AccountPolicyManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.SetAccountPolicy(ctx, policy)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to set account policy"))
+}
+
+// accountPolicyManagers returns the AccountPolicyManager implementations from the generic providers.
+func accountPolicyManagers(generic []interface{}) (implementations []accountPolicyManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := accountPolicyManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case AccountPolicyManager:
+			temp.AccountPolicyManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not an AccountPolicyManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no AccountPolicyManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetAccountPolicyFromInterfaces identifies implementations of the AccountPolicyManager interface and passes the found implementations to the getAccountPolicy() wrapper method.
+func GetAccountPolicyFromInterfaces(ctx context.Context, generic []interface{}) (policy AccountPolicy, metadata Metadata, err error) {
+	implementations, err := accountPolicyManagers(generic)
+	if err != nil {
+		return policy, metadata, err
+	}
+
+	return getAccountPolicy(ctx, implementations)
+}
+
+// SetAccountPolicyFromInterfaces identifies implementations of the AccountPolicyManager interface and passes the found implementations to the setAccountPolicy() wrapper method.
+func SetAccountPolicyFromInterfaces(ctx context.Context, policy AccountPolicy, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := accountPolicyManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return setAccountPolicy(ctx, policy, implementations)
+}
@@ -0,0 +1,122 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type accountPolicyManagerTester struct {
+	policy      AccountPolicy
+	returnError error
+}
+
+func (a *accountPolicyManagerTester) GetAccountPolicy(ctx context.Context) (AccountPolicy, error) {
+	return a.policy, a.returnError
+}
+
+func (a *accountPolicyManagerTester) SetAccountPolicy(ctx context.Context, policy AccountPolicy) error {
+	if a.returnError != nil {
+		return a.returnError
+	}
+
+	a.policy = policy
+
+	return nil
+}
+
+func (a *accountPolicyManagerTester) Name() string {
+	return "foo"
+}
+
+func TestAccountPolicyManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("account policy error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&accountPolicyManagerTester{returnError: tc.returnError}}
+			}
+
+			policy := AccountPolicy{
+				MinPasswordLength: ptr(12),
+				LockoutThreshold:  ptr(5),
+				LockoutDuration:   ptr(5 * time.Minute),
+				SessionTimeout:    ptr(30 * time.Minute),
+			}
+
+			metadata, err := SetAccountPolicyFromInterfaces(context.Background(), policy, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			got, metadata, err := GetAccountPolicyFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, policy, got)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+type namedAccountPolicyManagerTester struct {
+	accountPolicyManagerTester
+	name string
+}
+
+func (a *namedAccountPolicyManagerTester) Name() string {
+	return a.name
+}
+
+func TestGetAccountPolicyPrefersCompletePolicy(t *testing.T) {
+	// the generic provider does not report the vendor specific password complexity
+	partial := AccountPolicy{
+		MinPasswordLength:        ptr(8),
+		LockoutThreshold:         ptr(5),
+		LockoutDuration:          ptr(time.Minute),
+		LockoutCounterResetAfter: ptr(time.Minute),
+		SessionTimeout:           ptr(30 * time.Minute),
+	}
+
+	complete := partial
+	complete.PasswordComplexity = ptr(true)
+
+	generic := []interface{}{
+		&namedAccountPolicyManagerTester{accountPolicyManagerTester{policy: partial}, "gofish"},
+		&namedAccountPolicyManagerTester{accountPolicyManagerTester{policy: complete}, "dell"},
+	}
+
+	policy, metadata, err := GetAccountPolicyFromInterfaces(context.Background(), generic)
+	assert.Nil(t, err)
+	assert.Equal(t, "dell", metadata.SuccessfulProvider)
+	assert.Equal(t, complete, policy)
+
+	// the partial policy is returned when no provider reports all settings
+	policy, metadata, err = GetAccountPolicyFromInterfaces(context.Background(), generic[:1])
+	assert.Nil(t, err)
+	assert.Equal(t, "gofish", metadata.SuccessfulProvider)
+	assert.Equal(t, partial, policy)
+}
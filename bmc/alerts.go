@@ -0,0 +1,235 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// AlertDestination is a BMC SNMP trap (IPMI PET) alert destination.
+type AlertDestination struct {
+	// ID identifies the destination on the BMC - for example the ipmi LAN alert destination number,
+	// the iDRAC SNMPAlert index or the redfish event destination URI.
+	//
+	// An empty ID when setting a destination indicates a new destination is to be added, where supported.
+	ID string
+	// Address is the IP address or hostname the alerts are sent to.
+	Address string
+	// Community is the SNMP community string sent with the alerts,
+	// on most BMCs this is a single setting shared by all destinations.
+	Community string
+	// Enabled indicates alerts are sent to the destination.
+	Enabled bool
+}
+
+// AlertFilter is a BMC event filter, events matching an enabled filter trigger an alert.
+type AlertFilter struct {
+	// ID identifies the filter on the BMC - for example the ipmi PEF event filter number.
+	ID string
+	// Description is a human readable summary of the events matched by the filter.
+	Description string
+	// Enabled indicates the filter is active.
+	Enabled bool
+}
+
+// AlertDestinationManager gets and sets the BMC alert destinations and filters.
+type AlertDestinationManager interface {
+	AlertDestinations(ctx context.Context) (destinations []AlertDestination, err error)
+	SetAlertDestination(ctx context.Context, destination AlertDestination) (err error)
+	AlertFilters(ctx context.Context) (filters []AlertFilter, err error)
+	SetAlertFilter(ctx context.Context, filter AlertFilter) (err error)
+}
+
+type alertDestinationManagerProvider struct {
+	name string
+	AlertDestinationManager
+}
+
+// alertDestinations returns the alert destinations from the first successful provider.
+func alertDestinations(ctx context.Context, generic []alertDestinationManagerProvider) (destinations []AlertDestination, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.AlertDestinationManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return destinations, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			destinations, vErr := elem.AlertDestinations(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return destinations, metadata, nil
+		}
+	}
+
+	return destinations, metadata, multierror.Append(err, errors.New("failure to get alert destinations"))
+}
+
+// setAlertDestination sets the alert destination with the first successful provider.
+func setAlertDestination(ctx context.Context, destination AlertDestination, generic []alertDestinationManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.AlertDestinationManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.SetAlertDestination(ctx, destination)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to set alert destination"))
+}
+
+// alertFilters returns the alert filters from the first successful provider.
+func alertFilters(ctx context.Context, generic []alertDestinationManagerProvider) (filters []AlertFilter, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.AlertDestinationManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return filters, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			filters, vErr := elem.AlertFilters(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return filters, metadata, nil
+		}
+	}
+
+	return filters, metadata, multierror.Append(err, errors.New("failure to get alert filters"))
+}
+
+// setAlertFilter sets the alert filter with the first successful provider.
+func setAlertFilter(ctx context.Context, filter AlertFilter, generic []alertDestinationManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.AlertDestinationManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.SetAlertFilter(ctx, filter)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to set alert filter"))
+}
+
+// alertDestinationManagers returns the AlertDestinationManager implementations from the generic providers.
+func alertDestinationManagers(generic []interface{}) (implementations []alertDestinationManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := alertDestinationManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case AlertDestinationManager:
+			temp.AlertDestinationManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not an AlertDestinationManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no AlertDestinationManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// AlertDestinationsFromInterfaces identifies implementations of the AlertDestinationManager interface and passes the found implementations to the alertDestinations() wrapper method.
+func AlertDestinationsFromInterfaces(ctx context.Context, generic []interface{}) (destinations []AlertDestination, metadata Metadata, err error) {
+	implementations, err := alertDestinationManagers(generic)
+	if err != nil {
+		return destinations, metadata, err
+	}
+
+	return alertDestinations(ctx, implementations)
+}
+
+// SetAlertDestinationFromInterfaces identifies implementations of the AlertDestinationManager interface and passes the found implementations to the setAlertDestination() wrapper method.
+func SetAlertDestinationFromInterfaces(ctx context.Context, destination AlertDestination, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := alertDestinationManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return setAlertDestination(ctx, destination, implementations)
+}
+
+// AlertFiltersFromInterfaces identifies implementations of the AlertDestinationManager interface and passes the found implementations to the alertFilters() wrapper method.
+func AlertFiltersFromInterfaces(ctx context.Context, generic []interface{}) (filters []AlertFilter, metadata Metadata, err error) {
+	implementations, err := alertDestinationManagers(generic)
+	if err != nil {
+		return filters, metadata, err
+	}
+
+	return alertFilters(ctx, implementations)
+}
+
+// SetAlertFilterFromInterfaces identifies implementations of the AlertDestinationManager interface and passes the found implementations to the setAlertFilter() wrapper method.
+func SetAlertFilterFromInterfaces(ctx context.Context, filter AlertFilter, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := alertDestinationManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return setAlertFilter(ctx, filter, implementations)
+}
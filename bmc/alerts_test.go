@@ -0,0 +1,96 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type alertDestinationManagerTester struct {
+	destinations []AlertDestination
+	filters      []AlertFilter
+	returnError  error
+}
+
+func (a *alertDestinationManagerTester) AlertDestinations(ctx context.Context) ([]AlertDestination, error) {
+	return a.destinations, a.returnError
+}
+
+func (a *alertDestinationManagerTester) SetAlertDestination(ctx context.Context, destination AlertDestination) error {
+	if a.returnError != nil {
+		return a.returnError
+	}
+
+	a.destinations = append(a.destinations, destination)
+
+	return nil
+}
+
+func (a *alertDestinationManagerTester) AlertFilters(ctx context.Context) ([]AlertFilter, error) {
+	return a.filters, a.returnError
+}
+
+func (a *alertDestinationManagerTester) SetAlertFilter(ctx context.Context, filter AlertFilter) error {
+	if a.returnError != nil {
+		return a.returnError
+	}
+
+	a.filters = append(a.filters, filter)
+
+	return nil
+}
+
+func (a *alertDestinationManagerTester) Name() string {
+	return "foo"
+}
+
+func TestAlertDestinationManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("alert config error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&alertDestinationManagerTester{returnError: tc.returnError}}
+			}
+
+			destination := AlertDestination{ID: "1", Address: "192.0.2.10", Community: "public", Enabled: true}
+			filter := AlertFilter{ID: "1", Enabled: true}
+
+			metadata, err := SetAlertDestinationFromInterfaces(context.Background(), destination, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			destinations, _, err := AlertDestinationsFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, []AlertDestination{destination}, destinations)
+
+			metadata, err = SetAlertFilterFromInterfaces(context.Background(), filter, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			filters, _, err := AlertFiltersFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, []AlertFilter{filter}, filters)
+		})
+	}
+}
@@ -3,7 +3,10 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -18,8 +21,44 @@ type biosConfigurationGetterProvider struct {
 	BiosConfigurationGetter
 }
 
+// PendingBiosConfigurationGetter returns the BIOS attribute changes staged to be applied on the next reset.
+type PendingBiosConfigurationGetter interface {
+	GetPendingBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error)
+}
+
+type pendingBiosConfigurationGetterProvider struct {
+	name string
+	PendingBiosConfigurationGetter
+}
+
+// BiosApplyTime is when the BMC applies BIOS configuration changes,
+// the zero value applies the changes at the provider default.
+type BiosApplyTime struct {
+	// ApplyTime is one of constants.Immediate, constants.OnReset or constants.AtMaintenanceWindowStart.
+	ApplyTime constants.OperationApplyTime
+	// MaintenanceWindowStart and MaintenanceWindowDuration are required with constants.AtMaintenanceWindowStart.
+	MaintenanceWindowStart    time.Time
+	MaintenanceWindowDuration time.Duration
+}
+
+// Validate returns an error when the apply time is unknown or the maintenance window is incomplete.
+func (a BiosApplyTime) Validate() error {
+	switch a.ApplyTime {
+	case "", constants.Immediate, constants.OnReset:
+		return nil
+	case constants.AtMaintenanceWindowStart:
+		if a.MaintenanceWindowStart.IsZero() || a.MaintenanceWindowDuration <= 0 {
+			return errors.Wrap(bmclibErrs.ErrBiosApplyTime, "maintenance window start and duration required")
+		}
+
+		return nil
+	default:
+		return errors.Wrap(bmclibErrs.ErrBiosApplyTime, string(a.ApplyTime))
+	}
+}
+
 type BiosConfigurationSetter interface {
-	SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error)
+	SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime BiosApplyTime) (err error)
 	SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error)
 }
 
@@ -29,7 +68,7 @@ type biosConfigurationSetterProvider struct {
 }
 
 type BiosConfigurationResetter interface {
-	ResetBiosConfiguration(ctx context.Context) (err error)
+	ResetBiosConfiguration(ctx context.Context, applyTime BiosApplyTime) (err error)
 }
 
 type biosConfigurationResetterProvider struct {
@@ -65,7 +104,34 @@ Loop:
 	return biosConfig, metadata, multierror.Append(err, errors.New("failure to get bios configuration"))
 }
 
-func setBiosConfiguration(ctx context.Context, generic []biosConfigurationSetterProvider, biosConfig map[string]string) (metadata Metadata, err error) {
+func pendingBiosConfiguration(ctx context.Context, generic []pendingBiosConfigurationGetterProvider) (biosConfig map[string]string, metadata Metadata, err error) {
+	metadata = newMetadata()
+Loop:
+	for _, elem := range generic {
+		if elem.PendingBiosConfigurationGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+			break Loop
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			biosConfig, vErr := elem.GetPendingBiosConfiguration(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return biosConfig, metadata, nil
+		}
+	}
+
+	return biosConfig, metadata, multierror.Append(err, errors.New("failure to get pending bios configuration"))
+}
+
+func setBiosConfiguration(ctx context.Context, generic []biosConfigurationSetterProvider, biosConfig map[string]string, applyTime BiosApplyTime) (metadata Metadata, err error) {
 	metadata = newMetadata()
 Loop:
 	for _, elem := range generic {
@@ -78,7 +144,7 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			vErr := elem.SetBiosConfiguration(ctx, biosConfig)
+			vErr := elem.SetBiosConfiguration(ctx, biosConfig, applyTime)
 			if vErr != nil {
 				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
 				err = multierror.Append(err, vErr)
@@ -121,7 +187,7 @@ Loop:
 	return metadata, multierror.Append(err, errors.New("failure to set bios configuration from file"))
 }
 
-func resetBiosConfiguration(ctx context.Context, generic []biosConfigurationResetterProvider) (metadata Metadata, err error) {
+func resetBiosConfiguration(ctx context.Context, generic []biosConfigurationResetterProvider, applyTime BiosApplyTime) (metadata Metadata, err error) {
 	metadata = newMetadata()
 Loop:
 	for _, elem := range generic {
@@ -134,7 +200,7 @@ Loop:
 			break Loop
 		default:
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			vErr := elem.ResetBiosConfiguration(ctx)
+			vErr := elem.ResetBiosConfiguration(ctx, applyTime)
 			if vErr != nil {
 				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
 				err = multierror.Append(err, vErr)
@@ -178,7 +244,41 @@ func GetBiosConfigurationInterfaces(ctx context.Context, generic []interface{})
 	return biosConfiguration(ctx, implementations)
 }
 
-func SetBiosConfigurationInterfaces(ctx context.Context, generic []interface{}, biosConfig map[string]string) (metadata Metadata, err error) {
+// GetPendingBiosConfigurationInterfaces identifies implementations of the PendingBiosConfigurationGetter interface and passes the found implementations to the pendingBiosConfiguration() wrapper method.
+func GetPendingBiosConfigurationInterfaces(ctx context.Context, generic []interface{}) (biosConfig map[string]string, metadata Metadata, err error) {
+	implementations := make([]pendingBiosConfigurationGetterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := pendingBiosConfigurationGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case PendingBiosConfigurationGetter:
+			temp.PendingBiosConfigurationGetter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a PendingBiosConfigurationGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return biosConfig, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no PendingBiosConfigurationGetter implementations found"),
+			),
+		)
+	}
+
+	return pendingBiosConfiguration(ctx, implementations)
+}
+
+func SetBiosConfigurationInterfaces(ctx context.Context, generic []interface{}, biosConfig map[string]string, applyTime BiosApplyTime) (metadata Metadata, err error) {
+	if err := applyTime.Validate(); err != nil {
+		return metadata, err
+	}
+
 	implementations := make([]biosConfigurationSetterProvider, 0)
 	for _, elem := range generic {
 		if elem == nil {
@@ -204,7 +304,7 @@ func SetBiosConfigurationInterfaces(ctx context.Context, generic []interface{},
 		)
 	}
 
-	return setBiosConfiguration(ctx, implementations, biosConfig)
+	return setBiosConfiguration(ctx, implementations, biosConfig, applyTime)
 }
 
 func SetBiosConfigurationFromFileInterfaces(ctx context.Context, generic []interface{}, cfg string) (metadata Metadata, err error) {
@@ -236,7 +336,11 @@ func SetBiosConfigurationFromFileInterfaces(ctx context.Context, generic []inter
 	return setBiosConfigurationFromFile(ctx, implementations, cfg)
 }
 
-func ResetBiosConfigurationInterfaces(ctx context.Context, generic []interface{}) (metadata Metadata, err error) {
+func ResetBiosConfigurationInterfaces(ctx context.Context, generic []interface{}, applyTime BiosApplyTime) (metadata Metadata, err error) {
+	if err := applyTime.Validate(); err != nil {
+		return metadata, err
+	}
+
 	implementations := make([]biosConfigurationResetterProvider, 0)
 	for _, elem := range generic {
 		if elem == nil {
@@ -262,5 +366,135 @@ func ResetBiosConfigurationInterfaces(ctx context.Context, generic []interface{}
 		)
 	}
 
-	return resetBiosConfiguration(ctx, implementations)
+	return resetBiosConfiguration(ctx, implementations, applyTime)
+}
+
+// BiosAttributeDiff is a BIOS attribute whose desired value differs from the value it has after the next reset.
+type BiosAttributeDiff struct {
+	Name    string
+	Desired string
+	// Current is the attribute value in effect.
+	Current string
+	// Pending is the attribute value staged to be applied on the next reset, this is set when IsPending is true.
+	Pending   string
+	IsPending bool
+	// Unknown indicates the attribute is not present in the current configuration.
+	Unknown bool
+}
+
+// DiffBiosConfiguration returns the desired attributes that differ from the pending value when a change is staged,
+// and from the current value otherwise, sorted by attribute name.
+//
+// Attributes with a desired value equal to the staged value need no update,
+// while attributes with a desired value equal to the current value need an update when a different value is staged.
+func DiffBiosConfiguration(current, pending, desired map[string]string) []BiosAttributeDiff {
+	diffs := make([]BiosAttributeDiff, 0)
+
+	for name, value := range desired {
+		currentValue, exists := current[name]
+		pendingValue, isPending := pending[name]
+
+		effective := currentValue
+		if isPending {
+			effective = pendingValue
+		}
+
+		if exists && effective == value {
+			continue
+		}
+
+		diffs = append(diffs, BiosAttributeDiff{
+			Name:      name,
+			Desired:   value,
+			Current:   currentValue,
+			Pending:   pendingValue,
+			IsPending: isPending,
+			Unknown:   !exists,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+	return diffs
+}
+
+type biosConfigurationDifferProvider struct {
+	name string
+	BiosConfigurationGetter
+	// PendingBiosConfigurationGetter is nil for providers that don't read the pending configuration.
+	PendingBiosConfigurationGetter
+}
+
+// diffBiosConfiguration returns the BIOS configuration diff from the first provider that returns
+// both the current and pending configuration, so the two are never read from different providers.
+func diffBiosConfiguration(ctx context.Context, generic []biosConfigurationDifferProvider, desired map[string]string) (diffs []BiosAttributeDiff, metadata Metadata, err error) {
+	metadata = newMetadata()
+Loop:
+	for _, elem := range generic {
+		if elem.BiosConfigurationGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+			break Loop
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			current, vErr := elem.GetBiosConfiguration(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+
+			var pending map[string]string
+			if elem.PendingBiosConfigurationGetter != nil {
+				pending, vErr = elem.GetPendingBiosConfiguration(ctx)
+				if vErr != nil {
+					err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+					metadata.FailedProviderDetail[elem.name] = vErr.Error()
+					continue
+				}
+			}
+
+			metadata.SuccessfulProvider = elem.name
+			return DiffBiosConfiguration(current, pending, desired), metadata, nil
+		}
+	}
+
+	return diffs, metadata, multierror.Append(err, errors.New("failure to diff bios configuration"))
+}
+
+// DiffBiosConfigurationInterfaces identifies implementations of the BiosConfigurationGetter interface and passes the found implementations to the diffBiosConfiguration() wrapper method,
+// no attributes are pending for implementations that don't implement the PendingBiosConfigurationGetter interface.
+func DiffBiosConfigurationInterfaces(ctx context.Context, generic []interface{}, desired map[string]string) (diffs []BiosAttributeDiff, metadata Metadata, err error) {
+	implementations := make([]biosConfigurationDifferProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := biosConfigurationDifferProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case BiosConfigurationGetter:
+			temp.BiosConfigurationGetter = p
+			if pending, ok := elem.(PendingBiosConfigurationGetter); ok {
+				temp.PendingBiosConfigurationGetter = pending
+			}
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a BiosConfigurationGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return diffs, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no BiosConfigurationGetter implementations found"),
+			),
+		)
+	}
+
+	return diffBiosConfiguration(ctx, implementations, desired)
 }
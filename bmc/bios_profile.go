@@ -0,0 +1,171 @@
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// BiosConfigurationFormat is the file format of an exported BIOS configuration.
+type BiosConfigurationFormat string
+
+const (
+	// BiosConfigurationYAML is the vendor neutral BIOS profile in YAML.
+	BiosConfigurationYAML BiosConfigurationFormat = "yaml"
+	// BiosConfigurationJSON is the vendor neutral BIOS profile in JSON.
+	BiosConfigurationJSON BiosConfigurationFormat = "json"
+	// BiosConfigurationSCPXML is the Dell Server Configuration Profile in XML.
+	BiosConfigurationSCPXML BiosConfigurationFormat = "scp-xml"
+	// BiosConfigurationSCPJSON is the Dell Server Configuration Profile in JSON.
+	BiosConfigurationSCPJSON BiosConfigurationFormat = "scp-json"
+)
+
+// BiosProfile is the vendor neutral BIOS configuration file, the attributes are keyed
+// as with SetBiosConfiguration.
+//
+//	attributes:
+//	  boot_mode: UEFI
+//	  smt: Enabled
+//	  raw:NumLock: "On"
+type BiosProfile struct {
+	Attributes map[string]string `json:"attributes"`
+}
+
+// MarshalBiosProfile returns the BIOS configuration as a vendor neutral profile in the YAML or JSON format.
+func MarshalBiosProfile(biosConfig map[string]string, format BiosConfigurationFormat) (string, error) {
+	profile := BiosProfile{Attributes: biosConfig}
+
+	switch format {
+	case BiosConfigurationYAML:
+		b, err := yaml.Marshal(profile)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+	case BiosConfigurationJSON:
+		b, err := json.MarshalIndent(profile, "", "  ")
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+	default:
+		return "", errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, string(format))
+	}
+}
+
+// UnmarshalBiosProfile returns the BIOS configuration in the vendor neutral YAML or JSON profile.
+//
+// The attribute values may be any scalar, for example 'smt: true' or 'raw:SubNumaCluster: 2', and are returned
+// as written. YAML reads an unquoted On, Off, Yes or No as a boolean, such values are to be quoted.
+func UnmarshalBiosProfile(cfg string) (biosConfig map[string]string, err error) {
+	b, err := yaml.YAMLToJSON([]byte(cfg))
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, err.Error())
+	}
+
+	profile := struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	}{}
+
+	// numbers are kept as written, instead of being formatted from a float64
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&profile); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, err.Error())
+	}
+
+	if len(profile.Attributes) == 0 {
+		return nil, errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, "no attributes in BIOS profile")
+	}
+
+	biosConfig = make(map[string]string, len(profile.Attributes))
+
+	for attr, value := range profile.Attributes {
+		switch value.(type) {
+		case string, bool, json.Number:
+			biosConfig[attr] = fmt.Sprint(value)
+		default:
+			return nil, errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, "attribute value is not a scalar: "+attr)
+		}
+	}
+
+	return biosConfig, nil
+}
+
+// BiosConfigurationExporter exports the BIOS configuration in a file format.
+type BiosConfigurationExporter interface {
+	ExportBiosConfigurationToFile(ctx context.Context, format BiosConfigurationFormat) (cfg string, err error)
+}
+
+type biosConfigurationExporterProvider struct {
+	name string
+	BiosConfigurationExporter
+}
+
+// exportBiosConfiguration returns the BIOS configuration file from the first successful provider.
+func exportBiosConfiguration(ctx context.Context, generic []biosConfigurationExporterProvider, format BiosConfigurationFormat) (cfg string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.BiosConfigurationExporter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return cfg, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			cfg, vErr := elem.ExportBiosConfigurationToFile(ctx, format)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return cfg, metadata, nil
+		}
+	}
+
+	return cfg, metadata, multierror.Append(err, errors.New("failure to export bios configuration"))
+}
+
+// ExportBiosConfigurationInterfaces identifies implementations of the BiosConfigurationExporter interface and passes the found implementations to the exportBiosConfiguration() wrapper method.
+func ExportBiosConfigurationInterfaces(ctx context.Context, generic []interface{}, format BiosConfigurationFormat) (cfg string, metadata Metadata, err error) {
+	implementations := make([]biosConfigurationExporterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := biosConfigurationExporterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case BiosConfigurationExporter:
+			temp.BiosConfigurationExporter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a BiosConfigurationExporter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return cfg, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no BiosConfigurationExporter implementations found"),
+			),
+		)
+	}
+
+	return exportBiosConfiguration(ctx, implementations, format)
+}
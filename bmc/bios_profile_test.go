@@ -0,0 +1,127 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiosProfile(t *testing.T) {
+	biosConfig := map[string]string{
+		"boot_mode":   "UEFI",
+		"raw:NumLock": "On",
+	}
+
+	testCases := []struct {
+		testName string
+		format   BiosConfigurationFormat
+		expected string
+		err      error
+	}{
+		{"yaml", BiosConfigurationYAML, "attributes:\n  boot_mode: UEFI\n  raw:NumLock: \"On\"\n", nil},
+		{"json", BiosConfigurationJSON, "{\n  \"attributes\": {\n    \"boot_mode\": \"UEFI\",\n    \"raw:NumLock\": \"On\"\n  }\n}", nil},
+		{"unsupported format", BiosConfigurationSCPXML, "", bmclibErrs.ErrBiosConfigurationFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			cfg, err := MarshalBiosProfile(biosConfig, tc.format)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, cfg)
+
+			got, err := UnmarshalBiosProfile(cfg)
+			assert.Nil(t, err)
+			assert.Equal(t, biosConfig, got)
+		})
+	}
+}
+
+func TestUnmarshalBiosProfileScalars(t *testing.T) {
+	testCases := []struct {
+		testName string
+		cfg      string
+	}{
+		{"yaml", "attributes:\n  smt: true\n  raw:SubNumaCluster: 2\n  raw:MemFrequency: 3200.5\n  boot_mode: UEFI\n"},
+		{"json", `{"attributes": {"smt": true, "raw:SubNumaCluster": 2, "raw:MemFrequency": 3200.5, "boot_mode": "UEFI"}}`},
+	}
+
+	expected := map[string]string{
+		"smt":                "true",
+		"raw:SubNumaCluster": "2",
+		"raw:MemFrequency":   "3200.5",
+		"boot_mode":          "UEFI",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			got, err := UnmarshalBiosProfile(tc.cfg)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestUnmarshalBiosProfileInvalid(t *testing.T) {
+	for _, cfg := range []string{"", "attributes: {}", "<BiosCfg></BiosCfg>", "attributes: [a, b]", "attributes:\n  smt:\n", "attributes:\n  smt: [a]\n"} {
+		_, err := UnmarshalBiosProfile(cfg)
+		assert.ErrorIs(t, err, bmclibErrs.ErrBiosConfigurationFormat, cfg)
+	}
+}
+
+type biosConfigurationExporterTester struct {
+	returnError error
+}
+
+func (b *biosConfigurationExporterTester) ExportBiosConfigurationToFile(ctx context.Context, format BiosConfigurationFormat) (string, error) {
+	if b.returnError != nil {
+		return "", b.returnError
+	}
+
+	return "attributes:\n  boot_mode: UEFI\n", nil
+}
+
+func (b *biosConfigurationExporterTester) Name() string {
+	return "foo"
+}
+
+func TestExportBiosConfigurationInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("export bios configuration error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&biosConfigurationExporterTester{returnError: tc.returnError}}
+			}
+
+			cfg, metadata, err := ExportBiosConfigurationInterfaces(context.Background(), generic, BiosConfigurationYAML)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, "attributes:\n  boot_mode: UEFI\n", cfg)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// BiosAttributeType is the data type of a BIOS attribute value.
+type BiosAttributeType string
+
+const (
+	BiosAttributeEnumeration BiosAttributeType = "Enumeration"
+	BiosAttributeString      BiosAttributeType = "String"
+	BiosAttributeInteger     BiosAttributeType = "Integer"
+	BiosAttributeBoolean     BiosAttributeType = "Boolean"
+	BiosAttributePassword    BiosAttributeType = "Password"
+)
+
+// BiosAttribute is the BIOS attribute metadata listed in the BIOS attribute registry.
+type BiosAttribute struct {
+	Name        string
+	DisplayName string
+	Type        BiosAttributeType
+	ReadOnly    bool
+	// ResetRequired indicates a host reset is required to apply a change to the attribute.
+	ResetRequired bool
+	// Values are the allowed values of an Enumeration attribute.
+	Values []string
+	// LowerBound and UpperBound limit the value of an Integer attribute.
+	LowerBound *int64
+	UpperBound *int64
+	// MinLength and MaxLength limit the length of a String attribute value.
+	MinLength *int
+	MaxLength *int
+	// ValueExpression is the regular expression a String attribute value is to match.
+	ValueExpression string
+}
+
+// BiosAttributeRegistry is the BIOS attribute registry of a system, with the attributes keyed by name.
+type BiosAttributeRegistry struct {
+	ID         string
+	Version    string
+	Attributes map[string]BiosAttribute
+}
+
+// Validate returns an error for each BIOS attribute in the configuration that is unknown, read only,
+// or has a value not accepted by the registry.
+func (r BiosAttributeRegistry) Validate(biosConfig map[string]string) error {
+	names := make([]string, 0, len(biosConfig))
+	for name := range biosConfig {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var err error
+
+	for _, name := range names {
+		attribute, exists := r.Attributes[name]
+		if !exists {
+			err = multierror.Append(err, errors.Wrap(bmclibErrs.ErrBiosAttributeInvalid, name+": unknown attribute"))
+			continue
+		}
+
+		if vErr := attribute.validate(biosConfig[name]); vErr != nil {
+			err = multierror.Append(err, errors.Wrap(bmclibErrs.ErrBiosAttributeInvalid, name+": "+vErr.Error()))
+		}
+	}
+
+	return err
+}
+
+// validate returns an error when the value is not accepted for the attribute.
+func (a BiosAttribute) validate(value string) error {
+	if a.ReadOnly {
+		return errors.New("attribute is read only")
+	}
+
+	switch a.Type {
+	case BiosAttributeEnumeration:
+		for _, allowed := range a.Values {
+			if value == allowed {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("value %q is not one of %s", value, strings.Join(a.Values, ", "))
+	case BiosAttributeInteger:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("value %q is not an integer", value)
+		}
+
+		if a.LowerBound != nil && i < *a.LowerBound {
+			return fmt.Errorf("value %d is less than %d", i, *a.LowerBound)
+		}
+
+		if a.UpperBound != nil && i > *a.UpperBound {
+			return fmt.Errorf("value %d is greater than %d", i, *a.UpperBound)
+		}
+	case BiosAttributeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("value %q is not a boolean", value)
+		}
+	case BiosAttributeString, BiosAttributePassword:
+		if a.MinLength != nil && len(value) < *a.MinLength {
+			return fmt.Errorf("value is shorter than %d characters", *a.MinLength)
+		}
+
+		if a.MaxLength != nil && len(value) > *a.MaxLength {
+			return fmt.Errorf("value is longer than %d characters", *a.MaxLength)
+		}
+
+		if a.ValueExpression != "" {
+			// expressions not supported by the go regexp syntax are not checked
+			expr, err := regexp.Compile(a.ValueExpression)
+			if err == nil && !expr.MatchString(value) {
+				return fmt.Errorf("value %q does not match %s", value, a.ValueExpression)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BiosAttributeRegistryGetter returns the BIOS attribute registry.
+type BiosAttributeRegistryGetter interface {
+	BiosAttributeRegistry(ctx context.Context) (registry BiosAttributeRegistry, err error)
+}
+
+type biosAttributeRegistryGetterProvider struct {
+	name string
+	BiosAttributeRegistryGetter
+}
+
+// biosAttributeRegistry returns the BIOS attribute registry from the first successful provider.
+func biosAttributeRegistry(ctx context.Context, generic []biosAttributeRegistryGetterProvider) (registry BiosAttributeRegistry, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.BiosAttributeRegistryGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return registry, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			registry, vErr := elem.BiosAttributeRegistry(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return registry, metadata, nil
+		}
+	}
+
+	return registry, metadata, multierror.Append(err, errors.New("failure to get bios attribute registry"))
+}
+
+// GetBiosAttributeRegistryInterfaces identifies implementations of the BiosAttributeRegistryGetter interface and passes the found implementations to the biosAttributeRegistry() wrapper method.
+func GetBiosAttributeRegistryInterfaces(ctx context.Context, generic []interface{}) (registry BiosAttributeRegistry, metadata Metadata, err error) {
+	implementations := make([]biosAttributeRegistryGetterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := biosAttributeRegistryGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case BiosAttributeRegistryGetter:
+			temp.BiosAttributeRegistryGetter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a BiosAttributeRegistryGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return registry, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no BiosAttributeRegistryGetter implementations found"),
+			),
+		)
+	}
+
+	return biosAttributeRegistry(ctx, implementations)
+}
@@ -0,0 +1,105 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type biosAttributeRegistryGetterTester struct {
+	returnError error
+}
+
+func (b *biosAttributeRegistryGetterTester) BiosAttributeRegistry(ctx context.Context) (registry BiosAttributeRegistry, err error) {
+	if b.returnError != nil {
+		return registry, b.returnError
+	}
+
+	return BiosAttributeRegistry{ID: "BiosAttributeRegistry.v1_0_3"}, nil
+}
+
+func (b *biosAttributeRegistryGetterTester) Name() string {
+	return "foo"
+}
+
+func TestGetBiosAttributeRegistryInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("bios attribute registry error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&biosAttributeRegistryGetterTester{returnError: tc.returnError}}
+			}
+
+			registry, metadata, err := GetBiosAttributeRegistryInterfaces(context.Background(), generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, "BiosAttributeRegistry.v1_0_3", registry.ID)
+		})
+	}
+}
+
+func TestBiosAttributeRegistryValidate(t *testing.T) {
+	lowerBound, upperBound := int64(60), int64(600)
+	maxLength := 8
+
+	registry := BiosAttributeRegistry{
+		Attributes: map[string]BiosAttribute{
+			"BootMode":            {Name: "BootMode", Type: BiosAttributeEnumeration, Values: []string{"Bios", "Uefi"}},
+			"AcPwrRcvryUserDelay": {Name: "AcPwrRcvryUserDelay", Type: BiosAttributeInteger, LowerBound: &lowerBound, UpperBound: &upperBound},
+			"AssetTag":            {Name: "AssetTag", Type: BiosAttributeString, MaxLength: &maxLength, ValueExpression: "^[a-z0-9]*$"},
+			"SecureBootEnabled":   {Name: "SecureBootEnabled", Type: BiosAttributeBoolean},
+			"SystemServiceTag":    {Name: "SystemServiceTag", Type: BiosAttributeString, ReadOnly: true},
+		},
+	}
+
+	testCases := []struct {
+		testName   string
+		biosConfig map[string]string
+		errMsg     string
+	}{
+		{"valid", map[string]string{"BootMode": "Uefi", "AcPwrRcvryUserDelay": "120", "AssetTag": "rack01", "SecureBootEnabled": "true"}, ""},
+		{"unknown attribute", map[string]string{"Foo": "Bar"}, "Foo: unknown attribute"},
+		{"read only", map[string]string{"SystemServiceTag": "ABC1234"}, "SystemServiceTag: attribute is read only"},
+		{"enumeration", map[string]string{"BootMode": "Legacy"}, `BootMode: value "Legacy" is not one of Bios, Uefi`},
+		{"integer", map[string]string{"AcPwrRcvryUserDelay": "soon"}, `AcPwrRcvryUserDelay: value "soon" is not an integer`},
+		{"integer lower bound", map[string]string{"AcPwrRcvryUserDelay": "30"}, "AcPwrRcvryUserDelay: value 30 is less than 60"},
+		{"integer upper bound", map[string]string{"AcPwrRcvryUserDelay": "900"}, "AcPwrRcvryUserDelay: value 900 is greater than 600"},
+		{"string length", map[string]string{"AssetTag": "rack01rack01"}, "AssetTag: value is longer than 8 characters"},
+		{"string expression", map[string]string{"AssetTag": "Rack 1"}, `AssetTag: value "Rack 1" does not match ^[a-z0-9]*$`},
+		{"boolean", map[string]string{"SecureBootEnabled": "maybe"}, `SecureBootEnabled: value "maybe" is not a boolean`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := registry.Validate(tc.biosConfig)
+			if tc.errMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, bmclibErrs.ErrBiosAttributeInvalid)
+			assert.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type pendingBiosConfigurationGetterTester struct {
+	returnError error
+}
+
+func (p *pendingBiosConfigurationGetterTester) GetPendingBiosConfiguration(ctx context.Context) (map[string]string, error) {
+	if p.returnError != nil {
+		return nil, p.returnError
+	}
+
+	return map[string]string{"BootMode": "Uefi"}, nil
+}
+
+func (p *pendingBiosConfigurationGetterTester) Name() string {
+	return "foo"
+}
+
+func TestGetPendingBiosConfigurationInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("pending bios configuration error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&pendingBiosConfigurationGetterTester{returnError: tc.returnError}}
+			}
+
+			biosConfig, metadata, err := GetPendingBiosConfigurationInterfaces(context.Background(), generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, map[string]string{"BootMode": "Uefi"}, biosConfig)
+		})
+	}
+}
+
+func TestDiffBiosConfiguration(t *testing.T) {
+	current := map[string]string{
+		"BootMode":           "Bios",
+		"LogicalProc":        "Enabled",
+		"SriovGlobalEnable":  "Disabled",
+		"ProcVirtualization": "Enabled",
+	}
+
+	pending := map[string]string{
+		"BootMode":           "Uefi",
+		"ProcVirtualization": "Disabled",
+	}
+
+	desired := map[string]string{
+		// already staged
+		"BootMode": "Uefi",
+		// unchanged
+		"LogicalProc": "Enabled",
+		// changed
+		"SriovGlobalEnable": "Enabled",
+		// matches the current value, but a different value is staged
+		"ProcVirtualization": "Enabled",
+		// not a known attribute
+		"Foo": "Bar",
+	}
+
+	expected := []BiosAttributeDiff{
+		{Name: "Foo", Desired: "Bar", Unknown: true},
+		{Name: "ProcVirtualization", Desired: "Enabled", Current: "Enabled", Pending: "Disabled", IsPending: true},
+		{Name: "SriovGlobalEnable", Desired: "Enabled", Current: "Disabled"},
+	}
+
+	assert.Equal(t, expected, DiffBiosConfiguration(current, pending, desired))
+	assert.Empty(t, DiffBiosConfiguration(current, nil, map[string]string{"LogicalProc": "Enabled"}))
+}
+
+type biosConfigurationGetterTester struct {
+	name    string
+	current map[string]string
+}
+
+func (b *biosConfigurationGetterTester) GetBiosConfiguration(ctx context.Context) (map[string]string, error) {
+	return b.current, nil
+}
+
+func (b *biosConfigurationGetterTester) Name() string {
+	return b.name
+}
+
+type biosConfigurationDifferTester struct {
+	biosConfigurationGetterTester
+	pending    map[string]string
+	pendingErr error
+}
+
+func (b *biosConfigurationDifferTester) GetPendingBiosConfiguration(ctx context.Context) (map[string]string, error) {
+	return b.pending, b.pendingErr
+}
+
+func TestDiffBiosConfigurationInterfaces(t *testing.T) {
+	desired := map[string]string{"BootMode": "Uefi"}
+
+	staged := &biosConfigurationDifferTester{
+		biosConfigurationGetterTester: biosConfigurationGetterTester{name: "staged", current: map[string]string{"BootMode": "Bios"}},
+		pending:                       map[string]string{"BootMode": "Uefi"},
+	}
+
+	pendingFails := &biosConfigurationDifferTester{
+		biosConfigurationGetterTester: biosConfigurationGetterTester{name: "pendingFails", current: map[string]string{"BootMode": "Uefi"}},
+		pendingErr:                    errors.New("pending bios configuration error"),
+	}
+
+	noPending := &biosConfigurationGetterTester{name: "noPending", current: map[string]string{"BootMode": "Bios"}}
+
+	testCases := []struct {
+		testName           string
+		generic            []interface{}
+		expected           []BiosAttributeDiff
+		successfulProvider string
+		err                error
+	}{
+		{"current and pending staged", []interface{}{staged}, []BiosAttributeDiff{}, "staged", nil},
+		{
+			// the current configuration of the first provider is not diffed against the pending configuration of the second
+			"pending failure falls back to the next provider",
+			[]interface{}{pendingFails, staged},
+			[]BiosAttributeDiff{},
+			"staged",
+			nil,
+		},
+		{
+			"no pending configuration",
+			[]interface{}{noPending},
+			[]BiosAttributeDiff{{Name: "BootMode", Desired: "Uefi", Current: "Bios"}},
+			"noPending",
+			nil,
+		},
+		{"pending failure", []interface{}{pendingFails}, nil, "", pendingFails.pendingErr},
+		{"failure with bad implementation", []interface{}{&struct{}{}}, nil, "", bmclibErrs.ErrProviderImplementation},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			diffs, metadata, err := DiffBiosConfigurationInterfaces(context.Background(), tc.generic, desired)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.successfulProvider, metadata.SuccessfulProvider)
+			assert.Equal(t, tc.expected, diffs)
+		})
+	}
+}
+
+func TestBiosApplyTimeValidate(t *testing.T) {
+	testCases := []struct {
+		testName  string
+		applyTime BiosApplyTime
+		err       error
+	}{
+		{"provider default", BiosApplyTime{}, nil},
+		{"immediate", BiosApplyTime{ApplyTime: constants.Immediate}, nil},
+		{"on reset", BiosApplyTime{ApplyTime: constants.OnReset}, nil},
+		{
+			"maintenance window",
+			BiosApplyTime{
+				ApplyTime:                 constants.AtMaintenanceWindowStart,
+				MaintenanceWindowStart:    time.Now(),
+				MaintenanceWindowDuration: time.Hour,
+			},
+			nil,
+		},
+		{"maintenance window without window", BiosApplyTime{ApplyTime: constants.AtMaintenanceWindowStart}, bmclibErrs.ErrBiosApplyTime},
+		{"unsupported", BiosApplyTime{ApplyTime: constants.OnStartUpdateRequest}, bmclibErrs.ErrBiosApplyTime},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := tc.applyTime.Validate()
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// BootProgressStage is the bmclib normalized host boot stage.
+type BootProgressStage string
+
+const (
+	// BootProgressStageNone indicates the host has not started booting, or is powered off.
+	BootProgressStageNone BootProgressStage = "none"
+	// BootProgressStagePOST indicates the host is in the firmware power on self test,
+	// this covers the processor, memory, bus and PCI initialization stages.
+	BootProgressStagePOST BootProgressStage = "post"
+	// BootProgressStagePOSTComplete indicates the host firmware completed hardware initialization.
+	BootProgressStagePOSTComplete BootProgressStage = "post-complete"
+	// BootProgressStageSetup indicates the host firmware setup utility was entered.
+	BootProgressStageSetup BootProgressStage = "setup"
+	// BootProgressStageOSBootStarted indicates the host firmware handed off to the boot loader or OS.
+	BootProgressStageOSBootStarted BootProgressStage = "os-boot-started"
+	// BootProgressStageOSRunning indicates the OS is running.
+	BootProgressStageOSRunning BootProgressStage = "os-running"
+	// BootProgressStageOEM indicates the BMC returned a vendor specific stage, see BootProgress.State.
+	BootProgressStageOEM BootProgressStage = "oem"
+	// BootProgressStageUnknown indicates the stage returned by the BMC could not be identified.
+	BootProgressStageUnknown BootProgressStage = "unknown"
+)
+
+// BootProgress is the host boot progress as reported by the BMC.
+type BootProgress struct {
+	// Stage is the normalized boot stage.
+	Stage BootProgressStage
+	// State is the boot state as returned by the BMC.
+	State string
+	// Timestamp is the time the BMC last updated the boot state,
+	// providers that do not report this value set the time the state was read.
+	Timestamp time.Time
+}
+
+// the POST code states do not differentiate the boot loader from a running OS
+var postCodeBootProgressStages = map[string]BootProgressStage{
+	constants.POSTStateBootINIT: BootProgressStagePOST,
+	constants.POSTStateUEFI:     BootProgressStagePOSTComplete,
+	constants.POSTStateOS:       BootProgressStageOSBootStarted,
+}
+
+// PostCodeBootProgressStage returns the boot progress stage for a PostCodeGetter status.
+func PostCodeBootProgressStage(status string) BootProgressStage {
+	stage, exists := postCodeBootProgressStages[status]
+	if !exists {
+		return BootProgressStageUnknown
+	}
+
+	return stage
+}
+
+// BootProgressGetter retrieves the host boot progress from the BMC.
+type BootProgressGetter interface {
+	GetBootProgress(ctx context.Context) (BootProgress, error)
+}
+
+type bootProgressGetterProvider struct {
+	name string
+	BootProgressGetter
+}
+
+// bootProgress returns the host boot progress from the first successful provider.
+func bootProgress(ctx context.Context, generic []bootProgressGetterProvider) (progress BootProgress, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.BootProgressGetter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return progress, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			progress, vErr := elem.GetBootProgress(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return progress, metadata, nil
+		}
+	}
+
+	return progress, metadata, multierror.Append(err, errors.New("failure to get boot progress"))
+}
+
+// GetBootProgressFromInterfaces identifies implementations of the BootProgressGetter interface and passes the found implementations to the bootProgress() wrapper method.
+func GetBootProgressFromInterfaces(ctx context.Context, generic []interface{}) (progress BootProgress, metadata Metadata, err error) {
+	implementations := make([]bootProgressGetterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := bootProgressGetterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case BootProgressGetter:
+			temp.BootProgressGetter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a BootProgressGetter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return progress, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no BootProgressGetter implementations found"),
+			),
+		)
+	}
+
+	return bootProgress(ctx, implementations)
+}
@@ -0,0 +1,96 @@
+package bmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type bootProgressGetterTester struct {
+	returnProgress BootProgress
+	returnError    error
+}
+
+func (b *bootProgressGetterTester) GetBootProgress(ctx context.Context) (BootProgress, error) {
+	return b.returnProgress, b.returnError
+}
+
+func (b *bootProgressGetterTester) Name() string {
+	return "foo"
+}
+
+func TestBootProgress(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		returnProgress     BootProgress
+		returnError        error
+		ctxTimeout         time.Duration
+		providerName       string
+		providersAttempted int
+	}{
+		{"success with metadata", BootProgress{Stage: BootProgressStageOSRunning, State: "OSRunning"}, nil, 5 * time.Second, "foo", 1},
+		{"failure with metadata", BootProgress{}, bmclibErrs.ErrRedfishVersionIncompatible, 5 * time.Second, "foo", 1},
+		{"failure with context timeout", BootProgress{}, context.DeadlineExceeded, 1 * time.Nanosecond, "foo", 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			testImplementation := bootProgressGetterTester{returnProgress: tc.returnProgress, returnError: tc.returnError}
+			ctx, cancel := context.WithTimeout(context.Background(), tc.ctxTimeout)
+			defer cancel()
+			progress, metadata, err := bootProgress(ctx, []bootProgressGetterProvider{{tc.providerName, &testImplementation}})
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.returnProgress, progress)
+			assert.Equal(t, tc.providerName, metadata.SuccessfulProvider)
+			assert.Equal(t, tc.providersAttempted, len(metadata.ProvidersAttempted))
+		})
+	}
+}
+
+func TestGetBootProgressFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnProgress    BootProgress
+		returnError       error
+		providerName      string
+		badImplementation bool
+	}{
+		{"success with metadata", BootProgress{Stage: BootProgressStagePOST, State: "MemoryInitializationStarted"}, nil, "foo", false},
+		{"failure with bad implementation", BootProgress{}, bmclibErrs.ErrProviderImplementation, "foo", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				testImplementation := &bootProgressGetterTester{returnProgress: tc.returnProgress, returnError: tc.returnError}
+				generic = []interface{}{testImplementation}
+			}
+			progress, metadata, err := GetBootProgressFromInterfaces(context.Background(), generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.returnProgress, progress)
+			assert.Equal(t, tc.providerName, metadata.SuccessfulProvider)
+		})
+	}
+}
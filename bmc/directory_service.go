@@ -0,0 +1,167 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// DirectoryServiceType is the kind of external account provider BMC logins are federated to.
+type DirectoryServiceType string
+
+const (
+	DirectoryServiceLDAP            DirectoryServiceType = "LDAP"
+	DirectoryServiceActiveDirectory DirectoryServiceType = "ActiveDirectory"
+)
+
+// DirectoryRoleMapping maps a directory group to a BMC role.
+type DirectoryRoleMapping struct {
+	// RemoteGroup is the directory group name or distinguished name.
+	RemoteGroup string
+	// LocalRole is the BMC role granted to the group members - for example 'Administrator', 'Operator', 'ReadOnly'.
+	LocalRole string
+}
+
+// DirectoryService is the BMC LDAP or Active Directory configuration.
+type DirectoryService struct {
+	Type DirectoryServiceType
+	// Enabled indicates BMC logins are authenticated against the directory.
+	Enabled bool
+	// ServiceAddresses are the directory server addresses or URIs.
+	ServiceAddresses []string
+	// BaseDistinguishedNames are the search bases for users and groups.
+	BaseDistinguishedNames []string
+	// UsernameAttribute is the user attribute matched with the login name - for example 'uid'.
+	UsernameAttribute string
+	// GroupsAttribute is the user attribute listing the user group memberships - for example 'memberOf'.
+	GroupsAttribute string
+	// BindUsername is the account the BMC binds to the directory with.
+	BindUsername string
+	// BindPassword is the bind account password, this is never returned by the BMC.
+	BindPassword string
+	// RoleMappings are the group to BMC role mappings,
+	// a nil value leaves the existing mappings unchanged when set.
+	RoleMappings []DirectoryRoleMapping
+}
+
+// DirectoryServiceManager gets and sets the BMC LDAP and Active Directory configuration.
+type DirectoryServiceManager interface {
+	GetDirectoryService(ctx context.Context, serviceType DirectoryServiceType) (service DirectoryService, err error)
+	SetDirectoryService(ctx context.Context, service DirectoryService) (err error)
+}
+
+type directoryServiceManagerProvider struct {
+	name string
+	DirectoryServiceManager
+}
+
+// getDirectoryService returns the directory service configuration from the first successful provider.
+func getDirectoryService(ctx context.Context, serviceType DirectoryServiceType, generic []directoryServiceManagerProvider) (service DirectoryService, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.DirectoryServiceManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return service, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			service, vErr := elem.GetDirectoryService(ctx, serviceType)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return service, metadata, nil
+		}
+	}
+
+	return service, metadata, multierror.Append(err, errors.New("failure to get directory service configuration"))
+}
+
+// setDirectoryService sets the directory service configuration with the first successful provider.
+func setDirectoryService(ctx context.Context, service DirectoryService, generic []directoryServiceManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.DirectoryServiceManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.SetDirectoryService(ctx, service)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to set directory service configuration"))
+}
+
+// directoryServiceManagers returns the DirectoryServiceManager implementations from the generic providers.
+func directoryServiceManagers(generic []interface{}) (implementations []directoryServiceManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := directoryServiceManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case DirectoryServiceManager:
+			temp.DirectoryServiceManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a DirectoryServiceManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no DirectoryServiceManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetDirectoryServiceFromInterfaces identifies implementations of the DirectoryServiceManager interface and passes the found implementations to the getDirectoryService() wrapper method.
+func GetDirectoryServiceFromInterfaces(ctx context.Context, serviceType DirectoryServiceType, generic []interface{}) (service DirectoryService, metadata Metadata, err error) {
+	implementations, err := directoryServiceManagers(generic)
+	if err != nil {
+		return service, metadata, err
+	}
+
+	return getDirectoryService(ctx, serviceType, implementations)
+}
+
+// SetDirectoryServiceFromInterfaces identifies implementations of the DirectoryServiceManager interface and passes the found implementations to the setDirectoryService() wrapper method.
+func SetDirectoryServiceFromInterfaces(ctx context.Context, service DirectoryService, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := directoryServiceManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return setDirectoryService(ctx, service, implementations)
+}
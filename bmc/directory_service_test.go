@@ -0,0 +1,81 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type directoryServiceManagerTester struct {
+	services    map[DirectoryServiceType]DirectoryService
+	returnError error
+}
+
+func (d *directoryServiceManagerTester) GetDirectoryService(ctx context.Context, serviceType DirectoryServiceType) (DirectoryService, error) {
+	return d.services[serviceType], d.returnError
+}
+
+func (d *directoryServiceManagerTester) SetDirectoryService(ctx context.Context, service DirectoryService) error {
+	if d.returnError != nil {
+		return d.returnError
+	}
+
+	d.services[service.Type] = service
+
+	return nil
+}
+
+func (d *directoryServiceManagerTester) Name() string {
+	return "foo"
+}
+
+func TestDirectoryServiceManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("directory service error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&directoryServiceManagerTester{
+					services:    map[DirectoryServiceType]DirectoryService{},
+					returnError: tc.returnError,
+				}}
+			}
+
+			service := DirectoryService{
+				Type:             DirectoryServiceLDAP,
+				Enabled:          true,
+				ServiceAddresses: []string{"ldaps://ldap.example.com"},
+				RoleMappings:     []DirectoryRoleMapping{{RemoteGroup: "admins", LocalRole: "Administrator"}},
+			}
+
+			metadata, err := SetDirectoryServiceFromInterfaces(context.Background(), service, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			got, metadata, err := GetDirectoryServiceFromInterfaces(context.Background(), DirectoryServiceLDAP, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, service, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// EraseTargetSystemPrefix prefixes an erase target that selects a component of the whole system erase,
+// for example system:BIOS, system:LCData or system:OverwritePD, the target is passed to the vendor without the prefix.
+const EraseTargetSystemPrefix = "system:"
+
+// DriveEraser securely erases drives and runs the whole system erase, used to decommission a system.
+//
+// The erase runs as tasks, the IDs of which are returned by SecureErase and polled with EraseTaskStatus.
+type DriveEraser interface {
+	// SecureErase erases the targets, drive IDs as listed by the storage controllers,
+	// and system erase components prefixed with EraseTargetSystemPrefix.
+	SecureErase(ctx context.Context, targets []string) (taskIDs []string, err error)
+	// EraseTaskStatus returns the state of the erase task along with the task progress.
+	EraseTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error)
+}
+
+// SplitEraseTargets returns the drive IDs and the system erase components of the erase targets.
+func SplitEraseTargets(targets []string) (drives, systemComponents []string) {
+	for _, target := range targets {
+		if component, found := strings.CutPrefix(target, EraseTargetSystemPrefix); found {
+			systemComponents = append(systemComponents, component)
+			continue
+		}
+
+		drives = append(drives, target)
+	}
+
+	return drives, systemComponents
+}
+
+type driveEraserProvider struct {
+	name string
+	DriveEraser
+}
+
+// secureErase erases the targets with the first successful provider.
+func secureErase(ctx context.Context, generic []driveEraserProvider, targets []string) (taskIDs []string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.DriveEraser == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return taskIDs, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			taskIDs, vErr := elem.SecureErase(ctx, targets)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return taskIDs, metadata, nil
+		}
+	}
+
+	return taskIDs, metadata, multierror.Append(err, errors.New("failure to secure erase"))
+}
+
+// eraseTaskStatus returns the status of the erase task from the first successful provider.
+func eraseTaskStatus(ctx context.Context, generic []driveEraserProvider, taskID string) (state constants.TaskState, status string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.DriveEraser == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return state, status, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			state, status, vErr := elem.EraseTaskStatus(ctx, taskID)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return state, status, metadata, nil
+		}
+	}
+
+	return state, status, metadata, multierror.Append(err, errors.New("failure to get erase task status"))
+}
+
+// driveErasers returns the DriveEraser implementations from the generic providers.
+func driveErasers(generic []interface{}) (implementations []driveEraserProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := driveEraserProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case DriveEraser:
+			temp.DriveEraser = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a DriveEraser implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no DriveEraser implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// SecureEraseFromInterfaces identifies implementations of the DriveEraser interface and passes the found implementations to the secureErase() wrapper method.
+func SecureEraseFromInterfaces(ctx context.Context, targets []string, generic []interface{}) (taskIDs []string, metadata Metadata, err error) {
+	if len(targets) == 0 {
+		return taskIDs, metadata, errors.Wrap(bmclibErrs.ErrSecureErase, "no erase targets given")
+	}
+
+	implementations, err := driveErasers(generic)
+	if err != nil {
+		return taskIDs, metadata, err
+	}
+
+	return secureErase(ctx, implementations, targets)
+}
+
+// EraseTaskStatusFromInterfaces identifies implementations of the DriveEraser interface and passes the found implementations to the eraseTaskStatus() wrapper method.
+func EraseTaskStatusFromInterfaces(ctx context.Context, taskID string, generic []interface{}) (state constants.TaskState, status string, metadata Metadata, err error) {
+	implementations, err := driveErasers(generic)
+	if err != nil {
+		return state, status, metadata, err
+	}
+
+	return eraseTaskStatus(ctx, implementations, taskID)
+}
@@ -0,0 +1,88 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type driveEraserTester struct {
+	returnError error
+}
+
+func (d *driveEraserTester) SecureErase(ctx context.Context, targets []string) ([]string, error) {
+	if d.returnError != nil {
+		return nil, d.returnError
+	}
+
+	taskIDs := []string{}
+	for _, target := range targets {
+		taskIDs = append(taskIDs, "JID_"+target)
+	}
+
+	return taskIDs, nil
+}
+
+func (d *driveEraserTester) EraseTaskStatus(ctx context.Context, taskID string) (constants.TaskState, string, error) {
+	return constants.Running, "id: " + taskID, d.returnError
+}
+
+func (d *driveEraserTester) Name() string {
+	return "foo"
+}
+
+func TestSecureEraseFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		targets           []string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", []string{"Disk.Bay.0", "system:BIOS"}, nil, false},
+		{"failure with metadata", []string{"Disk.Bay.0"}, errors.New("erase error"), false},
+		{"failure with no targets", nil, bmclibErrs.ErrSecureErase, false},
+		{"failure with bad implementation", []string{"Disk.Bay.0"}, bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			tester := &driveEraserTester{returnError: tc.returnError}
+
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{tester}
+			}
+
+			ctx := context.Background()
+
+			taskIDs, metadata, err := SecureEraseFromInterfaces(ctx, tc.targets, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, []string{"JID_Disk.Bay.0", "JID_system:BIOS"}, taskIDs)
+
+			state, status, metadata, err := EraseTaskStatusFromInterfaces(ctx, taskIDs[0], generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, constants.Running, state)
+			assert.Equal(t, "id: JID_Disk.Bay.0", status)
+		})
+	}
+}
+
+func TestSplitEraseTargets(t *testing.T) {
+	drives, systemComponents := SplitEraseTargets([]string{"Disk.Bay.0", "system:BIOS", "Disk.Bay.1", "system:LCData"})
+	assert.Equal(t, []string{"Disk.Bay.0", "Disk.Bay.1"}, drives)
+	assert.Equal(t, []string{"BIOS", "LCData"}, systemComponents)
+}
@@ -0,0 +1,187 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// EventSubscription is a BMC event subscription,
+// events matching the subscription are sent by the BMC to the Destination.
+type EventSubscription struct {
+	// ID identifies the subscription on the BMC, this is set by the BMC on create.
+	ID string
+	// Destination is the URL events are sent to.
+	Destination string
+	// EventTypes limits the events sent to the given event types,
+	// this is deprecated in Redfish in favor of RegistryPrefixes and ResourceTypes.
+	EventTypes []string
+	// RegistryPrefixes limits the events sent to the message registry prefixes - for example 'Base', 'TaskEvent'.
+	RegistryPrefixes []string
+	// ResourceTypes limits the events sent to the resource types - for example 'Systems', 'Task'.
+	ResourceTypes []string
+	// Context is a client supplied string that is sent with each event.
+	Context string
+}
+
+// EventSubscriber creates, lists and deletes BMC event subscriptions.
+type EventSubscriber interface {
+	CreateEventSubscription(ctx context.Context, subscription EventSubscription) (id string, err error)
+	EventSubscriptions(ctx context.Context) (subscriptions []EventSubscription, err error)
+	DeleteEventSubscription(ctx context.Context, id string) (err error)
+}
+
+type eventSubscriberProvider struct {
+	name string
+	EventSubscriber
+}
+
+// createEventSubscription creates the event subscription with the first successful provider.
+func createEventSubscription(ctx context.Context, subscription EventSubscription, generic []eventSubscriberProvider) (id string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.EventSubscriber == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return id, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			id, vErr := elem.CreateEventSubscription(ctx, subscription)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return id, metadata, nil
+		}
+	}
+
+	return id, metadata, multierror.Append(err, errors.New("failure to create event subscription"))
+}
+
+// eventSubscriptions returns the event subscriptions from the first successful provider.
+func eventSubscriptions(ctx context.Context, generic []eventSubscriberProvider) (subscriptions []EventSubscription, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.EventSubscriber == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return subscriptions, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			subscriptions, vErr := elem.EventSubscriptions(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return subscriptions, metadata, nil
+		}
+	}
+
+	return subscriptions, metadata, multierror.Append(err, errors.New("failure to list event subscriptions"))
+}
+
+// deleteEventSubscription deletes the event subscription with the first successful provider.
+func deleteEventSubscription(ctx context.Context, id string, generic []eventSubscriberProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.EventSubscriber == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.DeleteEventSubscription(ctx, id)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to delete event subscription"))
+}
+
+// eventSubscribers returns the EventSubscriber implementations from the generic providers.
+func eventSubscribers(generic []interface{}) (implementations []eventSubscriberProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := eventSubscriberProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case EventSubscriber:
+			temp.EventSubscriber = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not an EventSubscriber implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no EventSubscriber implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// CreateEventSubscriptionFromInterfaces identifies implementations of the EventSubscriber interface and passes the found implementations to the createEventSubscription() wrapper method.
+func CreateEventSubscriptionFromInterfaces(ctx context.Context, subscription EventSubscription, generic []interface{}) (id string, metadata Metadata, err error) {
+	implementations, err := eventSubscribers(generic)
+	if err != nil {
+		return id, metadata, err
+	}
+
+	return createEventSubscription(ctx, subscription, implementations)
+}
+
+// EventSubscriptionsFromInterfaces identifies implementations of the EventSubscriber interface and passes the found implementations to the eventSubscriptions() wrapper method.
+func EventSubscriptionsFromInterfaces(ctx context.Context, generic []interface{}) (subscriptions []EventSubscription, metadata Metadata, err error) {
+	implementations, err := eventSubscribers(generic)
+	if err != nil {
+		return subscriptions, metadata, err
+	}
+
+	return eventSubscriptions(ctx, implementations)
+}
+
+// DeleteEventSubscriptionFromInterfaces identifies implementations of the EventSubscriber interface and passes the found implementations to the deleteEventSubscription() wrapper method.
+func DeleteEventSubscriptionFromInterfaces(ctx context.Context, id string, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := eventSubscribers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return deleteEventSubscription(ctx, id, implementations)
+}
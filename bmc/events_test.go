@@ -0,0 +1,93 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventSubscriberTester struct {
+	subscriptions []EventSubscription
+	returnError   error
+}
+
+func (e *eventSubscriberTester) CreateEventSubscription(ctx context.Context, subscription EventSubscription) (string, error) {
+	if e.returnError != nil {
+		return "", e.returnError
+	}
+
+	subscription.ID = "/redfish/v1/EventService/Subscriptions/1"
+	e.subscriptions = append(e.subscriptions, subscription)
+
+	return subscription.ID, nil
+}
+
+func (e *eventSubscriberTester) EventSubscriptions(ctx context.Context) ([]EventSubscription, error) {
+	return e.subscriptions, e.returnError
+}
+
+func (e *eventSubscriberTester) DeleteEventSubscription(ctx context.Context, id string) error {
+	if e.returnError != nil {
+		return e.returnError
+	}
+
+	e.subscriptions = nil
+
+	return nil
+}
+
+func (e *eventSubscriberTester) Name() string {
+	return "foo"
+}
+
+func TestEventSubscriptionFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("event service error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&eventSubscriberTester{returnError: tc.returnError}}
+			}
+
+			subscription := EventSubscription{Destination: "https://192.0.2.10/events"}
+
+			id, metadata, err := CreateEventSubscriptionFromInterfaces(context.Background(), subscription, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "/redfish/v1/EventService/Subscriptions/1", id)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			subscriptions, _, err := EventSubscriptionsFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(subscriptions))
+			assert.Equal(t, subscription.Destination, subscriptions[0].Destination)
+
+			metadata, err = DeleteEventSubscriptionFromInterfaces(context.Background(), id, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"foo"}, metadata.ProvidersAttempted)
+
+			subscriptions, _, err = EventSubscriptionsFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(subscriptions))
+		})
+	}
+}
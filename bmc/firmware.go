@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
+	"math"
 
 	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bconsts "github.com/bmc-toolbox/bmclib/v2/constants"
@@ -38,6 +38,7 @@ type firmwareInstallerProvider struct {
 // firmwareInstall uploads and initiates firmware update for the component
 func firmwareInstall(ctx context.Context, component, operationApplyTime string, forceInstall bool, reader io.Reader, generic []firmwareInstallerProvider) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
+	replay := newFirmwareReplay(reader, 0)
 
 	for _, elem := range generic {
 		if elem.FirmwareInstaller == nil {
@@ -49,8 +50,13 @@ func firmwareInstall(ctx context.Context, component, operationApplyTime string,
 
 			return taskID, metadata, err
 		default:
+			payload, rErr := replay.next()
+			if rErr != nil {
+				return taskID, metadata, multierror.Append(err, rErr)
+			}
+
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareInstall(ctx, component, operationApplyTime, forceInstall, reader)
+			taskID, vErr := elem.FirmwareInstall(ctx, component, operationApplyTime, forceInstall, payload)
 			if vErr != nil {
 				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
@@ -65,6 +71,52 @@ func firmwareInstall(ctx context.Context, component, operationApplyTime string,
 	return taskID, metadata, multierror.Append(err, errors.New("failure in FirmwareInstall"))
 }
 
+// firmwareReplay hands the firmware payload to each provider attempted,
+// the payload is rewound for a provider attempted after a previous provider failed and may have read from it.
+type firmwareReplay struct {
+	reader   io.Reader
+	size     int64
+	attempts int
+}
+
+func newFirmwareReplay(reader io.Reader, size int64) *firmwareReplay {
+	return &firmwareReplay{reader: reader, size: size}
+}
+
+// next returns the reader for the next provider attempt.
+//
+// For attempts after the first, readers implementing io.Seeker are rewound to the start,
+// other readers implementing io.ReaderAt are read again from the start. An error is returned
+// for a reader that implements neither, as the payload can not be read again.
+func (f *firmwareReplay) next() (io.Reader, error) {
+	f.attempts++
+	if f.attempts == 1 {
+		return f.reader, nil
+	}
+
+	if seeker, ok := f.reader.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Wrap(bmclibErrs.ErrFirmwareUpload, "rewinding the firmware payload: "+err.Error())
+		}
+
+		return f.reader, nil
+	}
+
+	if readerAt, ok := f.reader.(io.ReaderAt); ok {
+		size := f.size
+		if size <= 0 {
+			size = math.MaxInt64
+		}
+
+		return io.NewSectionReader(readerAt, 0, size), nil
+	}
+
+	return nil, errors.Wrap(
+		bmclibErrs.ErrFirmwareUpload,
+		"the firmware payload was read by a previous provider and can not be read again, a reader implementing io.Seeker or io.ReaderAt is required",
+	)
+}
+
 // FirmwareInstallFromInterfaces identifies implementations of the FirmwareInstaller interface and passes the found implementations to the firmwareInstall() wrapper
 func FirmwareInstallFromInterfaces(ctx context.Context, component, operationApplyTime string, forceInstall bool, reader io.Reader, generic []interface{}) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
@@ -187,9 +239,14 @@ func FirmwareInstallStatusFromInterfaces(ctx context.Context, installVersion, co
 type FirmwareInstallProvider interface {
 	// FirmwareInstallUploadAndInitiate uploads _and_ initiates the firmware install process.
 	//
+	// parameters:
+	// component - the component slug for the component update being installed.
+	// reader - the io.Reader to the firmware update payload.
+	// size - the size of the firmware update payload in bytes.
+	//
 	// return values:
 	// taskID - A taskID is returned if the update process on the BMC returns an identifier for the update process.
-	FirmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File) (taskID string, err error)
+	FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error)
 }
 
 // firmwareInstallProvider is an internal struct to correlate an implementation/provider and its name
@@ -199,8 +256,9 @@ type firmwareInstallProvider struct {
 }
 
 // firmwareInstall uploads and initiates firmware update for the component
-func firmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File, generic []firmwareInstallProvider) (taskID string, metadata Metadata, err error) {
+func firmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64, generic []firmwareInstallProvider) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
+	replay := newFirmwareReplay(reader, size)
 
 	for _, elem := range generic {
 		if elem.FirmwareInstallProvider == nil {
@@ -212,8 +270,13 @@ func firmwareInstallUploadAndInitiate(ctx context.Context, component string, fil
 
 			return taskID, metadata, err
 		default:
+			payload, rErr := replay.next()
+			if rErr != nil {
+				return taskID, metadata, multierror.Append(err, rErr)
+			}
+
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareInstallUploadAndInitiate(ctx, component, file)
+			taskID, vErr := elem.FirmwareInstallUploadAndInitiate(ctx, component, payload, size)
 			if vErr != nil {
 				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
@@ -228,7 +291,7 @@ func firmwareInstallUploadAndInitiate(ctx context.Context, component string, fil
 }
 
 // FirmwareInstallUploadAndInitiateFromInterfaces identifies implementations of the FirmwareInstallProvider interface and passes the found implementations to the firmwareInstallUploadAndInitiate() wrapper
-func FirmwareInstallUploadAndInitiateFromInterfaces(ctx context.Context, component string, file *os.File, generic []interface{}) (taskID string, metadata Metadata, err error) {
+func FirmwareInstallUploadAndInitiateFromInterfaces(ctx context.Context, component string, reader io.Reader, size int64, generic []interface{}) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
 
 	implementations := make([]firmwareInstallProvider, 0)
@@ -256,7 +319,7 @@ func FirmwareInstallUploadAndInitiateFromInterfaces(ctx context.Context, compone
 		)
 	}
 
-	return firmwareInstallUploadAndInitiate(ctx, component, file, implementations)
+	return firmwareInstallUploadAndInitiate(ctx, component, reader, size, implementations)
 }
 
 // FirmwareInstallerUploaded defines an interface to install firmware that was previously uploaded with FirmwareUpload
@@ -412,8 +475,15 @@ func firmwareInstallSteps(ctx context.Context, component string, generic []firmw
 	return steps, metadata, multierror.Append(err, errors.New("failure in FirmwareInstallSteps"))
 }
 
+// FirmwareUploader defines an interface to upload firmware for install.
 type FirmwareUploader interface {
-	FirmwareUpload(ctx context.Context, component string, file *os.File) (uploadVerifyTaskID string, err error)
+	// FirmwareUpload uploads the firmware update payload to the BMC returning the upload verify task ID.
+	//
+	// parameters:
+	// component - the component slug for the component update being uploaded.
+	// reader - the io.Reader to the firmware update payload.
+	// size - the size of the firmware update payload in bytes.
+	FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (uploadVerifyTaskID string, err error)
 }
 
 // firmwareUploaderProvider is an internal struct to correlate an implementation/provider and its name
@@ -423,7 +493,7 @@ type firmwareUploaderProvider struct {
 }
 
 // FirmwareUploaderFromInterfaces identifies implementations of the FirmwareUploader interface and passes the found implementations to the firmwareUpload() wrapper.
-func FirmwareUploadFromInterfaces(ctx context.Context, component string, file *os.File, generic []interface{}) (taskID string, metadata Metadata, err error) {
+func FirmwareUploadFromInterfaces(ctx context.Context, component string, reader io.Reader, size int64, generic []interface{}) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
 
 	implementations := make([]firmwareUploaderProvider, 0)
@@ -451,11 +521,12 @@ func FirmwareUploadFromInterfaces(ctx context.Context, component string, file *o
 		)
 	}
 
-	return firmwareUpload(ctx, component, file, implementations)
+	return firmwareUpload(ctx, component, reader, size, implementations)
 }
 
-func firmwareUpload(ctx context.Context, component string, file *os.File, generic []firmwareUploaderProvider) (taskID string, metadata Metadata, err error) {
+func firmwareUpload(ctx context.Context, component string, reader io.Reader, size int64, generic []firmwareUploaderProvider) (taskID string, metadata Metadata, err error) {
 	metadata = newMetadata()
+	replay := newFirmwareReplay(reader, size)
 
 	for _, elem := range generic {
 		if elem.FirmwareUploader == nil {
@@ -467,8 +538,13 @@ func firmwareUpload(ctx context.Context, component string, file *os.File, generi
 
 			return taskID, metadata, err
 		default:
+			payload, rErr := replay.next()
+			if rErr != nil {
+				return taskID, metadata, multierror.Append(err, rErr)
+			}
+
 			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
-			taskID, vErr := elem.FirmwareUpload(ctx, component, file)
+			taskID, vErr := elem.FirmwareUpload(ctx, component, payload, size)
 			if vErr != nil {
 				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
 				metadata.FailedProviderDetail[elem.name] = err.Error()
@@ -1,6 +1,7 @@
 package bmc
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
@@ -210,7 +211,7 @@ type firmwareInstallUploadAndInitiateTester struct {
 	returnError  error
 }
 
-func (f *firmwareInstallUploadAndInitiateTester) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (f *firmwareInstallUploadAndInitiateTester) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
 	return f.returnTaskID, f.returnError
 }
 
@@ -242,7 +243,7 @@ func TestFirmwareInstallUploadAndInitiate(t *testing.T) {
 			}
 			ctx, cancel := context.WithTimeout(context.Background(), tc.ctxTimeout)
 			defer cancel()
-			taskID, metadata, err := firmwareInstallUploadAndInitiate(ctx, tc.component, tc.file, []firmwareInstallProvider{{tc.providerName, testImplementation}})
+			taskID, metadata, err := firmwareInstallUploadAndInitiate(ctx, tc.component, tc.file, 0, []firmwareInstallProvider{{tc.providerName, testImplementation}})
 			if tc.returnError != nil {
 				assert.ErrorIs(t, err, tc.returnError)
 				return
@@ -282,7 +283,7 @@ func TestFirmwareInstallUploadAndInitiateFromInterfaces(t *testing.T) {
 				testImplementation := &firmwareInstallUploadAndInitiateTester{returnTaskID: tc.returnTaskID, returnError: tc.returnError}
 				generic = []interface{}{testImplementation}
 			}
-			taskID, metadata, err := FirmwareInstallUploadAndInitiateFromInterfaces(context.Background(), tc.component, tc.file, generic)
+			taskID, metadata, err := FirmwareInstallUploadAndInitiateFromInterfaces(context.Background(), tc.component, tc.file, 0, generic)
 			if tc.returnError != nil {
 				assert.ErrorIs(t, err, tc.returnError)
 				return
@@ -398,7 +399,7 @@ type firmwareUploadTester struct {
 	returnError  error
 }
 
-func (f *firmwareUploadTester) FirmwareUpload(ctx context.Context, component string, file *os.File) (uploadVerifyTaskID string, err error) {
+func (f *firmwareUploadTester) FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (uploadVerifyTaskID string, err error) {
 	return f.returnTaskID, f.returnError
 }
 
@@ -430,7 +431,7 @@ func TestFirmwareUpload(t *testing.T) {
 			}
 			ctx, cancel := context.WithTimeout(context.Background(), tc.ctxTimeout)
 			defer cancel()
-			taskID, metadata, err := firmwareUpload(ctx, tc.component, tc.file, []firmwareUploaderProvider{{tc.providerName, &testImplementation}})
+			taskID, metadata, err := firmwareUpload(ctx, tc.component, tc.file, 0, []firmwareUploaderProvider{{tc.providerName, &testImplementation}})
 			if tc.returnError != nil {
 				assert.ErrorIs(t, err, tc.returnError)
 				return
@@ -446,6 +447,82 @@ func TestFirmwareUpload(t *testing.T) {
 	}
 }
 
+// firmwareUploadReadTester reads the whole payload before returning the error.
+type firmwareUploadReadTester struct {
+	read        []byte
+	returnError error
+}
+
+func (f *firmwareUploadReadTester) FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (uploadVerifyTaskID string, err error) {
+	f.read, err = io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	return "1234", f.returnError
+}
+
+// readerAtOnly implements io.Reader and io.ReaderAt, and not io.Seeker.
+type readerAtOnly struct {
+	io.Reader
+	io.ReaderAt
+}
+
+func TestFirmwareUploadFallback(t *testing.T) {
+	payload := []byte("firmware payload")
+
+	testCases := []struct {
+		name   string
+		reader func() io.Reader
+		err    error
+	}{
+		{
+			"seeker is rewound",
+			func() io.Reader { return bytes.NewReader(payload) },
+			nil,
+		},
+		{
+			"reader at is read again",
+			func() io.Reader {
+				r := bytes.NewReader(payload)
+				return readerAtOnly{r, r}
+			},
+			nil,
+		},
+		{
+			"reader can not be read again",
+			func() io.Reader { return io.MultiReader(bytes.NewReader(payload)) },
+			bmclibErrs.ErrFirmwareUpload,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failing := &firmwareUploadReadTester{returnError: bmclibErrs.ErrNon200Response}
+			succeeding := &firmwareUploadReadTester{}
+
+			taskID, metadata, err := firmwareUpload(
+				context.Background(),
+				common.SlugBIOS,
+				tc.reader(),
+				int64(len(payload)),
+				[]firmwareUploaderProvider{{"foo", failing}, {"bar", succeeding}},
+			)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Equal(t, []string{"foo"}, metadata.ProvidersAttempted)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "1234", taskID)
+			assert.Equal(t, "bar", metadata.SuccessfulProvider)
+			assert.Equal(t, payload, failing.read)
+			assert.Equal(t, payload, succeeding.read)
+		})
+	}
+}
+
 type firmwareInstallStepsGetterTester struct {
 	Steps []constants.FirmwareInstallStep
 	Err   error
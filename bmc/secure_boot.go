@@ -0,0 +1,271 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// UEFI Secure Boot modes, the mode follows from the keys enrolled and is changed by resetting or deleting the keys.
+const (
+	SecureBootSetupMode    = "SetupMode"
+	SecureBootUserMode     = "UserMode"
+	SecureBootAuditMode    = "AuditMode"
+	SecureBootDeployedMode = "DeployedMode"
+)
+
+// UEFI Secure Boot key databases.
+const (
+	SecureBootDatabasePK  = "PK"
+	SecureBootDatabaseKEK = "KEK"
+	SecureBootDatabaseDB  = "db"
+	SecureBootDatabaseDBX = "dbx"
+)
+
+// SecureBoot is the UEFI Secure Boot state of the host.
+type SecureBoot struct {
+	// Enabled indicates Secure Boot takes effect on the next boot.
+	Enabled bool
+	// CurrentBoot indicates Secure Boot is in effect for the current boot.
+	CurrentBoot bool
+	// Mode is the Secure Boot mode, SetupMode, UserMode, AuditMode or DeployedMode.
+	Mode string
+}
+
+// SecureBootCertificate is a certificate enrolled in a UEFI Secure Boot key database.
+type SecureBootCertificate struct {
+	ID       string
+	Database string
+	// Subject and Issuer are the certificate subject and issuer common names.
+	Subject        string
+	Issuer         string
+	ValidNotBefore string
+	ValidNotAfter  string
+	Fingerprint    string
+	// CertificateType is the format of the CertificateString, for example PEM.
+	CertificateType   string
+	CertificateString string
+}
+
+// SecureBootManager gets and sets the UEFI Secure Boot state and mode, and manages the Secure Boot keys.
+//
+// ResetSecureBootKeys restores the default keys, which returns the host to User mode,
+// DeleteSecureBootKeys deletes all keys including the PK, which puts the host in Setup mode.
+//
+// SetSecureBootMode changes the mode through the keys, Setup mode by deleting the PK and User mode by restoring
+// the default keys, the mode is left as is when the host is already in the mode. Audit and Deployed mode are entered
+// from the host firmware, Redfish has no action to enter them, and so these modes are rejected with ErrNotImplemented.
+type SecureBootManager interface {
+	GetSecureBoot(ctx context.Context) (secureBoot SecureBoot, err error)
+	SetSecureBoot(ctx context.Context, enabled bool) (err error)
+	SetSecureBootMode(ctx context.Context, mode string) (err error)
+	ResetSecureBootKeys(ctx context.Context) (err error)
+	DeleteSecureBootKeys(ctx context.Context) (err error)
+	SecureBootCertificates(ctx context.Context, database string) (certificates []SecureBootCertificate, err error)
+	ImportSecureBootCertificate(ctx context.Context, database, certificate string) (err error)
+}
+
+type secureBootManagerProvider struct {
+	name string
+	SecureBootManager
+}
+
+// getSecureBoot returns the Secure Boot state from the first successful provider.
+func getSecureBoot(ctx context.Context, generic []secureBootManagerProvider) (secureBoot SecureBoot, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SecureBootManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return secureBoot, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			secureBoot, vErr := elem.GetSecureBoot(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return secureBoot, metadata, nil
+		}
+	}
+
+	return secureBoot, metadata, multierror.Append(err, errors.New("failure to get secure boot"))
+}
+
+// secureBootCertificates returns the certificates in the Secure Boot key database from the first successful provider.
+func secureBootCertificates(ctx context.Context, database string, generic []secureBootManagerProvider) (certificates []SecureBootCertificate, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SecureBootManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return certificates, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			certificates, vErr := elem.SecureBootCertificates(ctx, database)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return certificates, metadata, nil
+		}
+	}
+
+	return certificates, metadata, multierror.Append(err, errors.New("failure to get secure boot certificates"))
+}
+
+// updateSecureBoot runs the Secure Boot change with the first successful provider.
+func updateSecureBoot(ctx context.Context, generic []secureBootManagerProvider, action string, update func(SecureBootManager) error) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SecureBootManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := update(elem.SecureBootManager)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to "+action))
+}
+
+// secureBootManagers returns the SecureBootManager implementations from the generic providers.
+func secureBootManagers(generic []interface{}) (implementations []secureBootManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := secureBootManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case SecureBootManager:
+			temp.SecureBootManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a SecureBootManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no SecureBootManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetSecureBootFromInterfaces identifies implementations of the SecureBootManager interface and passes the found implementations to the getSecureBoot() wrapper method.
+func GetSecureBootFromInterfaces(ctx context.Context, generic []interface{}) (secureBoot SecureBoot, metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return secureBoot, metadata, err
+	}
+
+	return getSecureBoot(ctx, implementations)
+}
+
+// SetSecureBootFromInterfaces identifies implementations of the SecureBootManager interface and enables or disables Secure Boot with the first successful provider.
+func SetSecureBootFromInterfaces(ctx context.Context, enabled bool, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateSecureBoot(ctx, implementations, "set secure boot", func(m SecureBootManager) error {
+		return m.SetSecureBoot(ctx, enabled)
+	})
+}
+
+// SetSecureBootModeFromInterfaces identifies implementations of the SecureBootManager interface and sets the Secure Boot mode with the first successful provider.
+func SetSecureBootModeFromInterfaces(ctx context.Context, mode string, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateSecureBoot(ctx, implementations, "set secure boot mode", func(m SecureBootManager) error {
+		return m.SetSecureBootMode(ctx, mode)
+	})
+}
+
+// ResetSecureBootKeysFromInterfaces identifies implementations of the SecureBootManager interface and resets the Secure Boot keys to default with the first successful provider.
+func ResetSecureBootKeysFromInterfaces(ctx context.Context, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateSecureBoot(ctx, implementations, "reset secure boot keys", func(m SecureBootManager) error {
+		return m.ResetSecureBootKeys(ctx)
+	})
+}
+
+// DeleteSecureBootKeysFromInterfaces identifies implementations of the SecureBootManager interface and deletes all Secure Boot keys with the first successful provider.
+func DeleteSecureBootKeysFromInterfaces(ctx context.Context, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateSecureBoot(ctx, implementations, "delete secure boot keys", func(m SecureBootManager) error {
+		return m.DeleteSecureBootKeys(ctx)
+	})
+}
+
+// SecureBootCertificatesFromInterfaces identifies implementations of the SecureBootManager interface and passes the found implementations to the secureBootCertificates() wrapper method.
+func SecureBootCertificatesFromInterfaces(ctx context.Context, database string, generic []interface{}) (certificates []SecureBootCertificate, metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return certificates, metadata, err
+	}
+
+	return secureBootCertificates(ctx, database, implementations)
+}
+
+// ImportSecureBootCertificateFromInterfaces identifies implementations of the SecureBootManager interface and imports the PEM certificate in the Secure Boot key database with the first successful provider.
+func ImportSecureBootCertificateFromInterfaces(ctx context.Context, database, certificate string, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := secureBootManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateSecureBoot(ctx, implementations, "import secure boot certificate", func(m SecureBootManager) error {
+		return m.ImportSecureBootCertificate(ctx, database, certificate)
+	})
+}
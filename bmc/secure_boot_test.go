@@ -0,0 +1,155 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type secureBootManagerTester struct {
+	secureBoot   SecureBoot
+	certificates map[string][]SecureBootCertificate
+	returnError  error
+}
+
+func (s *secureBootManagerTester) GetSecureBoot(ctx context.Context) (SecureBoot, error) {
+	return s.secureBoot, s.returnError
+}
+
+func (s *secureBootManagerTester) SetSecureBoot(ctx context.Context, enabled bool) error {
+	if s.returnError != nil {
+		return s.returnError
+	}
+
+	s.secureBoot.Enabled = enabled
+
+	return nil
+}
+
+func (s *secureBootManagerTester) SetSecureBootMode(ctx context.Context, mode string) error {
+	if s.returnError != nil {
+		return s.returnError
+	}
+
+	s.secureBoot.Mode = mode
+
+	return nil
+}
+
+func (s *secureBootManagerTester) ResetSecureBootKeys(ctx context.Context) error {
+	if s.returnError != nil {
+		return s.returnError
+	}
+
+	s.secureBoot.Mode = SecureBootUserMode
+
+	return nil
+}
+
+func (s *secureBootManagerTester) DeleteSecureBootKeys(ctx context.Context) error {
+	if s.returnError != nil {
+		return s.returnError
+	}
+
+	s.secureBoot.Mode = SecureBootSetupMode
+	s.certificates = nil
+
+	return nil
+}
+
+func (s *secureBootManagerTester) SecureBootCertificates(ctx context.Context, database string) ([]SecureBootCertificate, error) {
+	return s.certificates[database], s.returnError
+}
+
+func (s *secureBootManagerTester) ImportSecureBootCertificate(ctx context.Context, database, certificate string) error {
+	if s.returnError != nil {
+		return s.returnError
+	}
+
+	if s.certificates == nil {
+		s.certificates = map[string][]SecureBootCertificate{}
+	}
+
+	s.certificates[database] = append(s.certificates[database], SecureBootCertificate{Database: database, CertificateString: certificate})
+
+	return nil
+}
+
+func (s *secureBootManagerTester) Name() string {
+	return "foo"
+}
+
+func TestSecureBootManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("secure boot error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&secureBootManagerTester{
+					secureBoot:  SecureBoot{Mode: SecureBootUserMode},
+					returnError: tc.returnError,
+				}}
+			}
+
+			ctx := context.Background()
+
+			metadata, err := SetSecureBootFromInterfaces(ctx, true, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, _, err = GetSecureBootFromInterfaces(ctx, generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, err = ImportSecureBootCertificateFromInterfaces(ctx, SecureBootDatabaseDB, "cert", generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			_, err = DeleteSecureBootKeysFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+
+			secureBoot, metadata, err := GetSecureBootFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, SecureBoot{Enabled: true, Mode: SecureBootSetupMode}, secureBoot)
+
+			_, err = ImportSecureBootCertificateFromInterfaces(ctx, SecureBootDatabaseDB, "cert", generic)
+			assert.Nil(t, err)
+
+			certificates, _, err := SecureBootCertificatesFromInterfaces(ctx, SecureBootDatabaseDB, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, []SecureBootCertificate{{Database: SecureBootDatabaseDB, CertificateString: "cert"}}, certificates)
+
+			_, err = ResetSecureBootKeysFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+
+			secureBoot, _, _ = GetSecureBootFromInterfaces(ctx, generic)
+			assert.Equal(t, SecureBootUserMode, secureBoot.Mode)
+
+			_, err = SetSecureBootModeFromInterfaces(ctx, SecureBootSetupMode, generic)
+			assert.Nil(t, err)
+
+			secureBoot, _, _ = GetSecureBootFromInterfaces(ctx, generic)
+			assert.Equal(t, SecureBootSetupMode, secureBoot.Mode)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// RemoteSyslog is the BMC remote syslog configuration.
+type RemoteSyslog struct {
+	// Enabled indicates the BMC sends its logs to the Servers.
+	Enabled bool
+	// Servers are the syslog server addresses, BMCs support a limited number of servers.
+	Servers []string
+	// Port is the syslog server port, a zero value leaves the port unchanged when set.
+	Port int
+}
+
+// RemoteSyslogManager gets and sets the BMC remote syslog configuration.
+type RemoteSyslogManager interface {
+	GetRemoteSyslog(ctx context.Context) (syslog RemoteSyslog, err error)
+	SetRemoteSyslog(ctx context.Context, syslog RemoteSyslog) (err error)
+}
+
+type remoteSyslogManagerProvider struct {
+	name string
+	RemoteSyslogManager
+}
+
+// getRemoteSyslog returns the remote syslog configuration from the first successful provider.
+func getRemoteSyslog(ctx context.Context, generic []remoteSyslogManagerProvider) (syslog RemoteSyslog, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.RemoteSyslogManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return syslog, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			syslog, vErr := elem.GetRemoteSyslog(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return syslog, metadata, nil
+		}
+	}
+
+	return syslog, metadata, multierror.Append(err, errors.New("failure to get remote syslog configuration"))
+}
+
+// setRemoteSyslog sets the remote syslog configuration with the first successful provider.
+func setRemoteSyslog(ctx context.Context, syslog RemoteSyslog, generic []remoteSyslogManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.RemoteSyslogManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := elem.SetRemoteSyslog(ctx, syslog)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to set remote syslog configuration"))
+}
+
+// remoteSyslogManagers returns the RemoteSyslogManager implementations from the generic providers.
+func remoteSyslogManagers(generic []interface{}) (implementations []remoteSyslogManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := remoteSyslogManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case RemoteSyslogManager:
+			temp.RemoteSyslogManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a RemoteSyslogManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no RemoteSyslogManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetRemoteSyslogFromInterfaces identifies implementations of the RemoteSyslogManager interface and passes the found implementations to the getRemoteSyslog() wrapper method.
+func GetRemoteSyslogFromInterfaces(ctx context.Context, generic []interface{}) (syslog RemoteSyslog, metadata Metadata, err error) {
+	implementations, err := remoteSyslogManagers(generic)
+	if err != nil {
+		return syslog, metadata, err
+	}
+
+	return getRemoteSyslog(ctx, implementations)
+}
+
+// SetRemoteSyslogFromInterfaces identifies implementations of the RemoteSyslogManager interface and passes the found implementations to the setRemoteSyslog() wrapper method.
+func SetRemoteSyslogFromInterfaces(ctx context.Context, syslog RemoteSyslog, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := remoteSyslogManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return setRemoteSyslog(ctx, syslog, implementations)
+}
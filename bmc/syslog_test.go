@@ -0,0 +1,72 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type remoteSyslogManagerTester struct {
+	syslog      RemoteSyslog
+	returnError error
+}
+
+func (r *remoteSyslogManagerTester) GetRemoteSyslog(ctx context.Context) (RemoteSyslog, error) {
+	return r.syslog, r.returnError
+}
+
+func (r *remoteSyslogManagerTester) SetRemoteSyslog(ctx context.Context, syslog RemoteSyslog) error {
+	if r.returnError != nil {
+		return r.returnError
+	}
+
+	r.syslog = syslog
+
+	return nil
+}
+
+func (r *remoteSyslogManagerTester) Name() string {
+	return "foo"
+}
+
+func TestRemoteSyslogManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("syslog error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&remoteSyslogManagerTester{returnError: tc.returnError}}
+			}
+
+			syslog := RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.1"}, Port: 514}
+
+			metadata, err := SetRemoteSyslogFromInterfaces(context.Background(), syslog, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			got, _, err := GetRemoteSyslogFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, syslog, got)
+		})
+	}
+}
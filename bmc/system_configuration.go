@@ -0,0 +1,265 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// SystemConfigurationFormat is the file format of a system configuration profile.
+type SystemConfigurationFormat string
+
+// SystemConfigurationShutdownType is how the host is shut down to apply an imported system configuration profile.
+type SystemConfigurationShutdownType string
+
+const (
+	SystemConfigurationXML  SystemConfigurationFormat = "XML"
+	SystemConfigurationJSON SystemConfigurationFormat = "JSON"
+
+	// SystemConfigurationGraceful shuts down the host gracefully to apply the profile.
+	SystemConfigurationGraceful SystemConfigurationShutdownType = "Graceful"
+	// SystemConfigurationForced powers off the host to apply the profile.
+	SystemConfigurationForced SystemConfigurationShutdownType = "Forced"
+	// SystemConfigurationNoReboot stages the profile to be applied on the next host reboot.
+	SystemConfigurationNoReboot SystemConfigurationShutdownType = "NoReboot"
+)
+
+// SystemConfigurationExportOptions selects the components and format of an exported system configuration profile.
+type SystemConfigurationExportOptions struct {
+	// Targets are the components included in the profile, for example BIOS, RAID, NIC, IDRAC or LifecycleController,
+	// all components are included when no targets are given.
+	Targets []string
+	// Format is the profile file format, XML when not given.
+	Format SystemConfigurationFormat
+}
+
+// Validate returns an error when the export format is not supported.
+func (o SystemConfigurationExportOptions) Validate() error {
+	switch o.Format {
+	case "", SystemConfigurationXML, SystemConfigurationJSON:
+		return nil
+	default:
+		return errors.Wrap(bmclibErrs.ErrSystemConfiguration, "unsupported format: "+string(o.Format))
+	}
+}
+
+// SystemConfigurationImportOptions selects the components applied from an imported system configuration profile.
+type SystemConfigurationImportOptions struct {
+	// Targets are the components applied from the profile, all components in the profile are applied when no targets are given.
+	Targets []string
+	// ShutdownType is how the host is shut down to apply the profile, the profile is applied on the next host reboot when not given.
+	ShutdownType SystemConfigurationShutdownType
+	// Preview checks the profile against the system without applying it.
+	Preview bool
+}
+
+// Validate returns an error when the shutdown type is not supported.
+func (o SystemConfigurationImportOptions) Validate() error {
+	switch o.ShutdownType {
+	case "", SystemConfigurationGraceful, SystemConfigurationForced, SystemConfigurationNoReboot:
+		return nil
+	default:
+		return errors.Wrap(bmclibErrs.ErrSystemConfiguration, "unsupported shutdown type: "+string(o.ShutdownType))
+	}
+}
+
+// SystemConfigurationExporter exports the system configuration profile, used to clone the configuration of a system.
+type SystemConfigurationExporter interface {
+	ExportSystemConfiguration(ctx context.Context, opts SystemConfigurationExportOptions) (cfg string, err error)
+}
+
+// SystemConfigurationImporter imports a system configuration profile and returns the status of the import job.
+type SystemConfigurationImporter interface {
+	ImportSystemConfiguration(ctx context.Context, cfg string, opts SystemConfigurationImportOptions) (jobID string, err error)
+	SystemConfigurationJobStatus(ctx context.Context, jobID string) (state constants.TaskState, status string, err error)
+}
+
+type systemConfigurationExporterProvider struct {
+	name string
+	SystemConfigurationExporter
+}
+
+type systemConfigurationImporterProvider struct {
+	name string
+	SystemConfigurationImporter
+}
+
+// exportSystemConfiguration returns the system configuration profile from the first successful provider.
+func exportSystemConfiguration(ctx context.Context, generic []systemConfigurationExporterProvider, opts SystemConfigurationExportOptions) (cfg string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SystemConfigurationExporter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return cfg, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			cfg, vErr := elem.ExportSystemConfiguration(ctx, opts)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return cfg, metadata, nil
+		}
+	}
+
+	return cfg, metadata, multierror.Append(err, errors.New("failure to export system configuration"))
+}
+
+// ExportSystemConfigurationFromInterfaces identifies implementations of the SystemConfigurationExporter interface and passes the found implementations to the exportSystemConfiguration() wrapper method.
+func ExportSystemConfigurationFromInterfaces(ctx context.Context, generic []interface{}, opts SystemConfigurationExportOptions) (cfg string, metadata Metadata, err error) {
+	if err := opts.Validate(); err != nil {
+		return cfg, metadata, err
+	}
+
+	implementations := make([]systemConfigurationExporterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := systemConfigurationExporterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case SystemConfigurationExporter:
+			temp.SystemConfigurationExporter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a SystemConfigurationExporter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return cfg, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no SystemConfigurationExporter implementations found"),
+			),
+		)
+	}
+
+	return exportSystemConfiguration(ctx, implementations, opts)
+}
+
+// importSystemConfiguration imports the system configuration profile with the first successful provider.
+func importSystemConfiguration(ctx context.Context, generic []systemConfigurationImporterProvider, cfg string, opts SystemConfigurationImportOptions) (jobID string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SystemConfigurationImporter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return jobID, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			jobID, vErr := elem.ImportSystemConfiguration(ctx, cfg, opts)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return jobID, metadata, nil
+		}
+	}
+
+	return jobID, metadata, multierror.Append(err, errors.New("failure to import system configuration"))
+}
+
+// ImportSystemConfigurationFromInterfaces identifies implementations of the SystemConfigurationImporter interface and passes the found implementations to the importSystemConfiguration() wrapper method.
+func ImportSystemConfigurationFromInterfaces(ctx context.Context, generic []interface{}, cfg string, opts SystemConfigurationImportOptions) (jobID string, metadata Metadata, err error) {
+	if err := opts.Validate(); err != nil {
+		return jobID, metadata, err
+	}
+
+	implementations, err := systemConfigurationImporterImplementations(generic)
+	if len(implementations) == 0 {
+		return jobID, metadata, err
+	}
+
+	return importSystemConfiguration(ctx, implementations, cfg, opts)
+}
+
+// systemConfigurationJobStatus returns the status of the import job from the first successful provider.
+func systemConfigurationJobStatus(ctx context.Context, generic []systemConfigurationImporterProvider, jobID string) (state constants.TaskState, status string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.SystemConfigurationImporter == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return state, status, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			state, status, vErr := elem.SystemConfigurationJobStatus(ctx, jobID)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return state, status, metadata, nil
+		}
+	}
+
+	return state, status, metadata, multierror.Append(err, errors.New("failure to get system configuration job status"))
+}
+
+// SystemConfigurationJobStatusFromInterfaces identifies implementations of the SystemConfigurationImporter interface and passes the found implementations to the systemConfigurationJobStatus() wrapper method.
+func SystemConfigurationJobStatusFromInterfaces(ctx context.Context, generic []interface{}, jobID string) (state constants.TaskState, status string, metadata Metadata, err error) {
+	implementations, err := systemConfigurationImporterImplementations(generic)
+	if len(implementations) == 0 {
+		return state, status, metadata, err
+	}
+
+	return systemConfigurationJobStatus(ctx, implementations, jobID)
+}
+
+// systemConfigurationImporterImplementations returns the SystemConfigurationImporter implementations,
+// along with an error when none are found.
+func systemConfigurationImporterImplementations(generic []interface{}) (implementations []systemConfigurationImporterProvider, err error) {
+	implementations = make([]systemConfigurationImporterProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := systemConfigurationImporterProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case SystemConfigurationImporter:
+			temp.SystemConfigurationImporter = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a SystemConfigurationImporter implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+	if len(implementations) == 0 {
+		return implementations, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no SystemConfigurationImporter implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
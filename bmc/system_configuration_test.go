@@ -0,0 +1,122 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type systemConfigurationTester struct {
+	returnError error
+}
+
+func (s *systemConfigurationTester) ExportSystemConfiguration(ctx context.Context, opts SystemConfigurationExportOptions) (string, error) {
+	if s.returnError != nil {
+		return "", s.returnError
+	}
+
+	return "<SystemConfiguration></SystemConfiguration>", nil
+}
+
+func (s *systemConfigurationTester) ImportSystemConfiguration(ctx context.Context, cfg string, opts SystemConfigurationImportOptions) (string, error) {
+	if s.returnError != nil {
+		return "", s.returnError
+	}
+
+	return "JID_123", nil
+}
+
+func (s *systemConfigurationTester) SystemConfigurationJobStatus(ctx context.Context, jobID string) (constants.TaskState, string, error) {
+	if s.returnError != nil {
+		return "", "", s.returnError
+	}
+
+	return constants.Complete, "id: JID_123, state: Completed", nil
+}
+
+func (s *systemConfigurationTester) Name() string {
+	return "foo"
+}
+
+var systemConfigurationTestCases = []struct {
+	testName          string
+	returnError       error
+	badImplementation bool
+}{
+	{"success with metadata", nil, false},
+	{"failure with metadata", errors.New("system configuration error"), false},
+	{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+}
+
+func systemConfigurationTesters(returnError error, badImplementation bool) []interface{} {
+	if badImplementation {
+		badImplementation := struct{}{}
+		return []interface{}{&badImplementation}
+	}
+
+	return []interface{}{&systemConfigurationTester{returnError: returnError}}
+}
+
+func TestExportSystemConfigurationFromInterfaces(t *testing.T) {
+	for _, tc := range systemConfigurationTestCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			generic := systemConfigurationTesters(tc.returnError, tc.badImplementation)
+
+			cfg, metadata, err := ExportSystemConfigurationFromInterfaces(context.Background(), generic, SystemConfigurationExportOptions{})
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, "<SystemConfiguration></SystemConfiguration>", cfg)
+		})
+	}
+
+	_, _, err := ExportSystemConfigurationFromInterfaces(context.Background(), nil, SystemConfigurationExportOptions{Format: "INI"})
+	assert.ErrorIs(t, err, bmclibErrs.ErrSystemConfiguration)
+}
+
+func TestImportSystemConfigurationFromInterfaces(t *testing.T) {
+	for _, tc := range systemConfigurationTestCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			generic := systemConfigurationTesters(tc.returnError, tc.badImplementation)
+
+			jobID, metadata, err := ImportSystemConfigurationFromInterfaces(context.Background(), generic, "<SystemConfiguration></SystemConfiguration>", SystemConfigurationImportOptions{})
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, "JID_123", jobID)
+		})
+	}
+
+	_, _, err := ImportSystemConfigurationFromInterfaces(context.Background(), nil, "", SystemConfigurationImportOptions{ShutdownType: "PowerCycle"})
+	assert.ErrorIs(t, err, bmclibErrs.ErrSystemConfiguration)
+}
+
+func TestSystemConfigurationJobStatusFromInterfaces(t *testing.T) {
+	for _, tc := range systemConfigurationTestCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			generic := systemConfigurationTesters(tc.returnError, tc.badImplementation)
+
+			state, _, metadata, err := SystemConfigurationJobStatusFromInterfaces(context.Background(), generic, "JID_123")
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, constants.Complete, state)
+		})
+	}
+}
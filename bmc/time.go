@@ -0,0 +1,150 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// BMCTime is the BMC clock and time synchronization configuration.
+type BMCTime struct {
+	// DateTime is the current BMC date and time.
+	DateTime time.Time
+	// TimeZone is the BMC time zone name or its UTC offset - for example 'Europe/Amsterdam' or '+01:00'.
+	TimeZone string
+	// NTPEnabled indicates the BMC synchronizes its clock from the NTPServers.
+	NTPEnabled bool
+	// NTPServers are the NTP servers configured on the BMC.
+	NTPServers []string
+}
+
+// BMCTimeManager gets and sets the BMC clock, time zone and NTP servers.
+type BMCTimeManager interface {
+	GetBMCTime(ctx context.Context) (bmcTime BMCTime, err error)
+	SetBMCTime(ctx context.Context, dateTime time.Time) (err error)
+	SetBMCTimeZone(ctx context.Context, timeZone string) (err error)
+	SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error)
+}
+
+type bmcTimeManagerProvider struct {
+	name string
+	BMCTimeManager
+}
+
+// bmcTimeManagerFunc is a BMCTimeManager method invoked on each provider until one succeeds.
+type bmcTimeManagerFunc func(ctx context.Context, manager BMCTimeManager) error
+
+// runBMCTimeManager invokes fn on the providers and returns on the first successful provider.
+func runBMCTimeManager(ctx context.Context, fn bmcTimeManagerFunc, action string, generic []bmcTimeManagerProvider) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.BMCTimeManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := fn(ctx, elem.BMCTimeManager)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to "+action))
+}
+
+// bmcTimeManagers returns the BMCTimeManager implementations from the generic providers.
+func bmcTimeManagers(generic []interface{}) (implementations []bmcTimeManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := bmcTimeManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case BMCTimeManager:
+			temp.BMCTimeManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a BMCTimeManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no BMCTimeManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetBMCTimeFromInterfaces identifies implementations of the BMCTimeManager interface and returns the BMC time from the first successful provider.
+func GetBMCTimeFromInterfaces(ctx context.Context, generic []interface{}) (bmcTime BMCTime, metadata Metadata, err error) {
+	implementations, err := bmcTimeManagers(generic)
+	if err != nil {
+		return bmcTime, metadata, err
+	}
+
+	metadata, err = runBMCTimeManager(ctx, func(ctx context.Context, manager BMCTimeManager) error {
+		var vErr error
+		bmcTime, vErr = manager.GetBMCTime(ctx)
+		return vErr
+	}, "get BMC time", implementations)
+
+	return bmcTime, metadata, err
+}
+
+// SetBMCTimeFromInterfaces identifies implementations of the BMCTimeManager interface and sets the BMC date and time with the first successful provider.
+func SetBMCTimeFromInterfaces(ctx context.Context, dateTime time.Time, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := bmcTimeManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return runBMCTimeManager(ctx, func(ctx context.Context, manager BMCTimeManager) error {
+		return manager.SetBMCTime(ctx, dateTime)
+	}, "set BMC time", implementations)
+}
+
+// SetBMCTimeZoneFromInterfaces identifies implementations of the BMCTimeManager interface and sets the BMC time zone with the first successful provider.
+func SetBMCTimeZoneFromInterfaces(ctx context.Context, timeZone string, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := bmcTimeManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return runBMCTimeManager(ctx, func(ctx context.Context, manager BMCTimeManager) error {
+		return manager.SetBMCTimeZone(ctx, timeZone)
+	}, "set BMC time zone", implementations)
+}
+
+// SetNTPServersFromInterfaces identifies implementations of the BMCTimeManager interface and sets the BMC NTP configuration with the first successful provider.
+func SetNTPServersFromInterfaces(ctx context.Context, enabled bool, servers []string, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := bmcTimeManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return runBMCTimeManager(ctx, func(ctx context.Context, manager BMCTimeManager) error {
+		return manager.SetNTPServers(ctx, enabled, servers)
+	}, "set NTP servers", implementations)
+}
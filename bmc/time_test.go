@@ -0,0 +1,103 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type bmcTimeManagerTester struct {
+	bmcTime     BMCTime
+	returnError error
+}
+
+func (b *bmcTimeManagerTester) GetBMCTime(ctx context.Context) (BMCTime, error) {
+	return b.bmcTime, b.returnError
+}
+
+func (b *bmcTimeManagerTester) SetBMCTime(ctx context.Context, dateTime time.Time) error {
+	if b.returnError != nil {
+		return b.returnError
+	}
+
+	b.bmcTime.DateTime = dateTime
+
+	return nil
+}
+
+func (b *bmcTimeManagerTester) SetBMCTimeZone(ctx context.Context, timeZone string) error {
+	if b.returnError != nil {
+		return b.returnError
+	}
+
+	b.bmcTime.TimeZone = timeZone
+
+	return nil
+}
+
+func (b *bmcTimeManagerTester) SetNTPServers(ctx context.Context, enabled bool, servers []string) error {
+	if b.returnError != nil {
+		return b.returnError
+	}
+
+	b.bmcTime.NTPEnabled = enabled
+	b.bmcTime.NTPServers = servers
+
+	return nil
+}
+
+func (b *bmcTimeManagerTester) Name() string {
+	return "foo"
+}
+
+func TestBMCTimeManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("bmc time error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&bmcTimeManagerTester{returnError: tc.returnError}}
+			}
+
+			now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			metadata, err := SetBMCTimeFromInterfaces(context.Background(), now, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			_, err = SetBMCTimeZoneFromInterfaces(context.Background(), "UTC", generic)
+			assert.Nil(t, err)
+
+			_, err = SetNTPServersFromInterfaces(context.Background(), true, []string{"192.0.2.1"}, generic)
+			assert.Nil(t, err)
+
+			bmcTime, metadata, err := GetBMCTimeFromInterfaces(context.Background(), generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			expected := BMCTime{DateTime: now, TimeZone: "UTC", NTPEnabled: true, NTPServers: []string{"192.0.2.1"}}
+			assert.Equal(t, expected, bmcTime)
+		})
+	}
+}
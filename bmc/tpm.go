@@ -0,0 +1,155 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// TPM is the state of the host Trusted Platform Module.
+type TPM struct {
+	Present bool
+	Enabled bool
+	// InterfaceType is the TPM interface, for example TPM1_2 or TPM2_0.
+	InterfaceType   string
+	FirmwareVersion string
+}
+
+// TPMManager gets the TPM state, enables or disables the TPM and clears the TPM.
+//
+// The TPM is changed through BIOS settings, the changes are applied on the next host reset.
+type TPMManager interface {
+	GetTPM(ctx context.Context) (tpm TPM, err error)
+	SetTPM(ctx context.Context, enabled bool) (err error)
+	ClearTPM(ctx context.Context) (err error)
+}
+
+type tpmManagerProvider struct {
+	name string
+	TPMManager
+}
+
+// getTPM returns the TPM state from the first successful provider.
+func getTPM(ctx context.Context, generic []tpmManagerProvider) (tpm TPM, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.TPMManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return tpm, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			tpm, vErr := elem.GetTPM(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return tpm, metadata, nil
+		}
+	}
+
+	return tpm, metadata, multierror.Append(err, errors.New("failure to get TPM"))
+}
+
+// updateTPM runs the TPM change with the first successful provider.
+func updateTPM(ctx context.Context, generic []tpmManagerProvider, action string, update func(TPMManager) error) (metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.TPMManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			vErr := update(elem.TPMManager)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return metadata, nil
+		}
+	}
+
+	return metadata, multierror.Append(err, errors.New("failure to "+action))
+}
+
+// tpmManagers returns the TPMManager implementations from the generic providers.
+func tpmManagers(generic []interface{}) (implementations []tpmManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := tpmManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case TPMManager:
+			temp.TPMManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a TPMManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no TPMManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// GetTPMFromInterfaces identifies implementations of the TPMManager interface and passes the found implementations to the getTPM() wrapper method.
+func GetTPMFromInterfaces(ctx context.Context, generic []interface{}) (tpm TPM, metadata Metadata, err error) {
+	implementations, err := tpmManagers(generic)
+	if err != nil {
+		return tpm, metadata, err
+	}
+
+	return getTPM(ctx, implementations)
+}
+
+// SetTPMFromInterfaces identifies implementations of the TPMManager interface and enables or disables the TPM with the first successful provider.
+func SetTPMFromInterfaces(ctx context.Context, enabled bool, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := tpmManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateTPM(ctx, implementations, "set TPM", func(m TPMManager) error {
+		return m.SetTPM(ctx, enabled)
+	})
+}
+
+// ClearTPMFromInterfaces identifies implementations of the TPMManager interface and clears the TPM with the first successful provider.
+func ClearTPMFromInterfaces(ctx context.Context, generic []interface{}) (metadata Metadata, err error) {
+	implementations, err := tpmManagers(generic)
+	if err != nil {
+		return metadata, err
+	}
+
+	return updateTPM(ctx, implementations, "clear TPM", func(m TPMManager) error {
+		return m.ClearTPM(ctx)
+	})
+}
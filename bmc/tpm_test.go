@@ -0,0 +1,100 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type tpmManagerTester struct {
+	tpm         TPM
+	cleared     bool
+	returnError error
+}
+
+func (m *tpmManagerTester) GetTPM(ctx context.Context) (TPM, error) {
+	return m.tpm, m.returnError
+}
+
+func (m *tpmManagerTester) SetTPM(ctx context.Context, enabled bool) error {
+	if m.returnError != nil {
+		return m.returnError
+	}
+
+	m.tpm.Enabled = enabled
+
+	return nil
+}
+
+func (m *tpmManagerTester) ClearTPM(ctx context.Context) error {
+	if m.returnError != nil {
+		return m.returnError
+	}
+
+	m.cleared = true
+
+	return nil
+}
+
+func (m *tpmManagerTester) Name() string {
+	return "foo"
+}
+
+func TestTPMManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("tpm error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			tester := &tpmManagerTester{
+				tpm:         TPM{Present: true, InterfaceType: "TPM2_0", FirmwareVersion: "1.3.1.0"},
+				returnError: tc.returnError,
+			}
+
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{tester}
+			}
+
+			ctx := context.Background()
+
+			metadata, err := SetTPMFromInterfaces(ctx, true, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, _, err = GetTPMFromInterfaces(ctx, generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, err = ClearTPMFromInterfaces(ctx, generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			tpm, metadata, err := GetTPMFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, TPM{Present: true, Enabled: true, InterfaceType: "TPM2_0", FirmwareVersion: "1.3.1.0"}, tpm)
+
+			_, err = ClearTPMFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+			assert.True(t, tester.cleared)
+		})
+	}
+}
@@ -14,7 +14,7 @@ type UserCreator interface {
 	UserCreate(ctx context.Context, user, pass, role string) (ok bool, err error)
 }
 
-// UserUpdater updates a user on a BMC
+// UserUpdater updates a user on a BMC, the password is left unchanged when pass is empty
 type UserUpdater interface {
 	UserUpdate(ctx context.Context, user, pass, role string) (ok bool, err error)
 }
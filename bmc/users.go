@@ -0,0 +1,134 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// The user account roles, these are the redfish standard role names.
+const (
+	UserRoleAdministrator = "Administrator"
+	UserRoleOperator      = "Operator"
+	UserRoleReadOnly      = "ReadOnly"
+	UserRoleNone          = "None"
+)
+
+// userRoles maps the lower case role and privilege names used by the providers to the user account roles.
+var userRoles = map[string]string{
+	"administrator": UserRoleAdministrator,
+	"admin":         UserRoleAdministrator,
+	"operator":      UserRoleOperator,
+	"readonly":      UserRoleReadOnly,
+	"user":          UserRoleReadOnly,
+	"callback":      UserRoleReadOnly,
+	"none":          UserRoleNone,
+	"noaccess":      UserRoleNone,
+	"no access":     UserRoleNone,
+}
+
+// NormalizeUserRole returns the user account role for the provider role or IPMI privilege name,
+// unknown roles are returned as is.
+func NormalizeUserRole(role string) string {
+	if normalized, exists := userRoles[strings.ToLower(strings.TrimSpace(role))]; exists {
+		return normalized
+	}
+
+	return role
+}
+
+// User is a BMC user account.
+type User struct {
+	ID       string
+	Username string
+	// Role is one of the UserRole values, or the provider role when it has no equivalent.
+	Role    string
+	Enabled bool
+	Locked  bool
+	// AccountTypes are the services the account can log in to - for example 'Redfish', 'WebUI', 'IPMI'.
+	AccountTypes []string
+	// ChannelPrivileges are the IPMI privilege names by channel number - for example {"1": "ADMINISTRATOR"}.
+	ChannelPrivileges map[string]string
+}
+
+// UserLister lists the BMC user accounts.
+type UserLister interface {
+	Users(ctx context.Context) (users []User, err error)
+}
+
+type userListerProvider struct {
+	name string
+	UserLister
+}
+
+// listUsers returns the user accounts from the first successful provider.
+func listUsers(ctx context.Context, timeout time.Duration, generic []userListerProvider) (users []User, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.UserLister == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return users, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			users, vErr := listUsersWithTimeout(ctx, timeout, elem)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return users, metadata, nil
+		}
+	}
+
+	return users, metadata, multierror.Append(err, errors.New("failure to list users"))
+}
+
+func listUsersWithTimeout(ctx context.Context, timeout time.Duration, elem userListerProvider) ([]User, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return elem.Users(ctx)
+}
+
+// UsersFromInterfaces identifies implementations of the UserLister interface and passes the found implementations to the listUsers() wrapper method.
+func UsersFromInterfaces(ctx context.Context, timeout time.Duration, generic []interface{}) (users []User, metadata Metadata, err error) {
+	implementations := make([]userListerProvider, 0)
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := userListerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case UserLister:
+			temp.UserLister = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a UserLister implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return users, metadata, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no UserLister implementations found"),
+			),
+		)
+	}
+
+	return listUsers(ctx, timeout, implementations)
+}
@@ -0,0 +1,79 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type userListerTester struct {
+	returnError error
+}
+
+func (u *userListerTester) Users(ctx context.Context) ([]User, error) {
+	if u.returnError != nil {
+		return nil, u.returnError
+	}
+
+	return []User{{ID: "2", Username: "admin", Role: UserRoleAdministrator, Enabled: true}}, nil
+}
+
+func (u *userListerTester) Name() string {
+	return "foo"
+}
+
+func TestUsersFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("list users error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{&userListerTester{returnError: tc.returnError}}
+			}
+
+			users, metadata, err := UsersFromInterfaces(context.Background(), time.Second, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, []User{{ID: "2", Username: "admin", Role: UserRoleAdministrator, Enabled: true}}, users)
+		})
+	}
+}
+
+func TestNormalizeUserRole(t *testing.T) {
+	testCases := map[string]string{
+		"Administrator": UserRoleAdministrator,
+		"ADMINISTRATOR": UserRoleAdministrator,
+		"operator":      UserRoleOperator,
+		"USER":          UserRoleReadOnly,
+		"ReadOnly":      UserRoleReadOnly,
+		"NO ACCESS":     UserRoleNone,
+		"Custom":        "Custom",
+	}
+
+	for role, expected := range testCases {
+		t.Run(role, func(t *testing.T) {
+			assert.Equal(t, expected, NormalizeUserRole(role))
+		})
+	}
+}
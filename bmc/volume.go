@@ -0,0 +1,297 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+)
+
+// StorageControllerMode is the personality of a storage controller.
+type StorageControllerMode string
+
+const (
+	// StorageControllerRAID presents the drives through volumes configured on the controller.
+	StorageControllerRAID StorageControllerMode = "RAID"
+	// StorageControllerHBA passes the drives through to the host as is.
+	StorageControllerHBA StorageControllerMode = "HBA"
+)
+
+// volumeMinimumDrives is the minimum number of member drives for each RAID type.
+var volumeMinimumDrives = map[string]int{
+	"RAID0":  1,
+	"RAID1":  2,
+	"RAID5":  3,
+	"RAID6":  4,
+	"RAID10": 4,
+	"RAID50": 6,
+	"RAID60": 8,
+}
+
+// Volume is a volume configured on a storage controller.
+type Volume struct {
+	ID   string
+	Name string
+	// Controller is the ID of the storage resource the volume is configured on, for example RAID.Integrated.1-1.
+	Controller string
+	// RAIDType is the Redfish RAID type, for example RAID1 or RAID10.
+	RAIDType       string
+	CapacityBytes  int64
+	StripSizeBytes int64
+	// Drives are the IDs of the member drives.
+	Drives []string
+	Health string
+}
+
+// VolumeSpec is the layout of a volume to create.
+type VolumeSpec struct {
+	// Controller is the ID of the storage resource the volume is created on.
+	Controller string
+	Name       string
+	// RAIDType is the Redfish RAID type, one of RAID0, RAID1, RAID5, RAID6, RAID10, RAID50 or RAID60.
+	RAIDType string
+	// Drives are the IDs of the member drives.
+	Drives []string
+	// StripSizeBytes is the strip size, the controller default is used when zero.
+	StripSizeBytes int64
+	// CapacityBytes is the volume size, the capacity of the member drives is used when zero.
+	CapacityBytes int64
+	// ApplyTime is when the volume is created, the controller default is used when not given.
+	ApplyTime constants.OperationApplyTime
+}
+
+// Validate returns an error when the volume spec is incomplete or the RAID type is not supported
+// with the number of member drives.
+func (s VolumeSpec) Validate() error {
+	if s.Controller == "" {
+		return errors.Wrap(bmclibErrs.ErrVolume, "no storage controller given")
+	}
+
+	minimum, exists := volumeMinimumDrives[s.RAIDType]
+	if !exists {
+		return errors.Wrap(bmclibErrs.ErrVolume, "unsupported RAID type: "+s.RAIDType)
+	}
+
+	if len(s.Drives) < minimum {
+		return errors.Wrap(bmclibErrs.ErrVolume, fmt.Sprintf("%s requires at least %d drives, got %d", s.RAIDType, minimum, len(s.Drives)))
+	}
+
+	return nil
+}
+
+// VolumeManager lists, creates, deletes and initializes the volumes on the storage controllers
+// and sets the storage controller mode.
+//
+// Changes that are not applied right away return the ID of the task or job applying them,
+// the status of which is returned by VolumeTaskStatus.
+type VolumeManager interface {
+	Volumes(ctx context.Context) (volumes []Volume, err error)
+	CreateVolume(ctx context.Context, spec VolumeSpec) (taskID string, err error)
+	DeleteVolume(ctx context.Context, controller, volumeID string) (taskID string, err error)
+	InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (taskID string, err error)
+	SetStorageControllerMode(ctx context.Context, controller string, mode StorageControllerMode) (taskID string, err error)
+	VolumeTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error)
+}
+
+type volumeManagerProvider struct {
+	name string
+	VolumeManager
+}
+
+// getVolumes returns the volumes from the first successful provider.
+func getVolumes(ctx context.Context, generic []volumeManagerProvider) (volumes []Volume, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.VolumeManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return volumes, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			volumes, vErr := elem.Volumes(ctx)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return volumes, metadata, nil
+		}
+	}
+
+	return volumes, metadata, multierror.Append(err, errors.New("failure to get volumes"))
+}
+
+// updateVolumes runs the volume change with the first successful provider and returns the task ID.
+func updateVolumes(ctx context.Context, generic []volumeManagerProvider, action string, update func(VolumeManager) (string, error)) (taskID string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.VolumeManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return taskID, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			taskID, vErr := update(elem.VolumeManager)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return taskID, metadata, nil
+		}
+	}
+
+	return taskID, metadata, multierror.Append(err, errors.New("failure to "+action))
+}
+
+// volumeTaskStatus returns the status of the volume task from the first successful provider.
+func volumeTaskStatus(ctx context.Context, generic []volumeManagerProvider, taskID string) (state constants.TaskState, status string, metadata Metadata, err error) {
+	metadata = newMetadata()
+
+	for _, elem := range generic {
+		if elem.VolumeManager == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+
+			return state, status, metadata, err
+		default:
+			metadata.ProvidersAttempted = append(metadata.ProvidersAttempted, elem.name)
+			state, status, vErr := elem.VolumeTaskStatus(ctx, taskID)
+			if vErr != nil {
+				err = multierror.Append(err, errors.WithMessagef(vErr, "provider: %v", elem.name))
+				metadata.FailedProviderDetail[elem.name] = vErr.Error()
+				continue
+			}
+			metadata.SuccessfulProvider = elem.name
+			return state, status, metadata, nil
+		}
+	}
+
+	return state, status, metadata, multierror.Append(err, errors.New("failure to get volume task status"))
+}
+
+// volumeManagers returns the VolumeManager implementations from the generic providers.
+func volumeManagers(generic []interface{}) (implementations []volumeManagerProvider, err error) {
+	for _, elem := range generic {
+		if elem == nil {
+			continue
+		}
+		temp := volumeManagerProvider{name: getProviderName(elem)}
+		switch p := elem.(type) {
+		case VolumeManager:
+			temp.VolumeManager = p
+			implementations = append(implementations, temp)
+		default:
+			e := fmt.Sprintf("not a VolumeManager implementation: %T", p)
+			err = multierror.Append(err, errors.New(e))
+		}
+	}
+
+	if len(implementations) == 0 {
+		return nil, multierror.Append(
+			err,
+			errors.Wrap(
+				bmclibErrs.ErrProviderImplementation,
+				("no VolumeManager implementations found"),
+			),
+		)
+	}
+
+	return implementations, nil
+}
+
+// VolumesFromInterfaces identifies implementations of the VolumeManager interface and passes the found implementations to the getVolumes() wrapper method.
+func VolumesFromInterfaces(ctx context.Context, generic []interface{}) (volumes []Volume, metadata Metadata, err error) {
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return volumes, metadata, err
+	}
+
+	return getVolumes(ctx, implementations)
+}
+
+// CreateVolumeFromInterfaces identifies implementations of the VolumeManager interface and creates the volume with the first successful provider.
+func CreateVolumeFromInterfaces(ctx context.Context, spec VolumeSpec, generic []interface{}) (taskID string, metadata Metadata, err error) {
+	if err := spec.Validate(); err != nil {
+		return taskID, metadata, err
+	}
+
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return taskID, metadata, err
+	}
+
+	return updateVolumes(ctx, implementations, "create volume", func(m VolumeManager) (string, error) {
+		return m.CreateVolume(ctx, spec)
+	})
+}
+
+// DeleteVolumeFromInterfaces identifies implementations of the VolumeManager interface and deletes the volume with the first successful provider.
+func DeleteVolumeFromInterfaces(ctx context.Context, controller, volumeID string, generic []interface{}) (taskID string, metadata Metadata, err error) {
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return taskID, metadata, err
+	}
+
+	return updateVolumes(ctx, implementations, "delete volume", func(m VolumeManager) (string, error) {
+		return m.DeleteVolume(ctx, controller, volumeID)
+	})
+}
+
+// InitializeVolumeFromInterfaces identifies implementations of the VolumeManager interface and initializes the volume with the first successful provider.
+func InitializeVolumeFromInterfaces(ctx context.Context, controller, volumeID string, fast bool, generic []interface{}) (taskID string, metadata Metadata, err error) {
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return taskID, metadata, err
+	}
+
+	return updateVolumes(ctx, implementations, "initialize volume", func(m VolumeManager) (string, error) {
+		return m.InitializeVolume(ctx, controller, volumeID, fast)
+	})
+}
+
+// SetStorageControllerModeFromInterfaces identifies implementations of the VolumeManager interface and sets the storage controller mode with the first successful provider.
+func SetStorageControllerModeFromInterfaces(ctx context.Context, controller string, mode StorageControllerMode, generic []interface{}) (taskID string, metadata Metadata, err error) {
+	switch mode {
+	case StorageControllerRAID, StorageControllerHBA:
+	default:
+		return taskID, metadata, errors.Wrap(bmclibErrs.ErrVolume, "unsupported storage controller mode: "+string(mode))
+	}
+
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return taskID, metadata, err
+	}
+
+	return updateVolumes(ctx, implementations, "set storage controller mode", func(m VolumeManager) (string, error) {
+		return m.SetStorageControllerMode(ctx, controller, mode)
+	})
+}
+
+// VolumeTaskStatusFromInterfaces identifies implementations of the VolumeManager interface and passes the found implementations to the volumeTaskStatus() wrapper method.
+func VolumeTaskStatusFromInterfaces(ctx context.Context, taskID string, generic []interface{}) (state constants.TaskState, status string, metadata Metadata, err error) {
+	implementations, err := volumeManagers(generic)
+	if err != nil {
+		return state, status, metadata, err
+	}
+
+	return volumeTaskStatus(ctx, implementations, taskID)
+}
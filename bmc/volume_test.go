@@ -0,0 +1,165 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type volumeManagerTester struct {
+	volumes     []Volume
+	mode        StorageControllerMode
+	returnError error
+}
+
+func (m *volumeManagerTester) Volumes(ctx context.Context) ([]Volume, error) {
+	return m.volumes, m.returnError
+}
+
+func (m *volumeManagerTester) CreateVolume(ctx context.Context, spec VolumeSpec) (string, error) {
+	if m.returnError != nil {
+		return "", m.returnError
+	}
+
+	m.volumes = append(m.volumes, Volume{ID: spec.Name, Name: spec.Name, Controller: spec.Controller, RAIDType: spec.RAIDType, Drives: spec.Drives})
+
+	return "JID_123", nil
+}
+
+func (m *volumeManagerTester) DeleteVolume(ctx context.Context, controller, volumeID string) (string, error) {
+	if m.returnError != nil {
+		return "", m.returnError
+	}
+
+	for idx, volume := range m.volumes {
+		if volume.Controller == controller && volume.ID == volumeID {
+			m.volumes = append(m.volumes[:idx], m.volumes[idx+1:]...)
+			return "JID_124", nil
+		}
+	}
+
+	return "", bmclibErrs.ErrVolume
+}
+
+func (m *volumeManagerTester) InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (string, error) {
+	return "JID_125", m.returnError
+}
+
+func (m *volumeManagerTester) SetStorageControllerMode(ctx context.Context, controller string, mode StorageControllerMode) (string, error) {
+	if m.returnError != nil {
+		return "", m.returnError
+	}
+
+	m.mode = mode
+
+	return "JID_126", nil
+}
+
+func (m *volumeManagerTester) VolumeTaskStatus(ctx context.Context, taskID string) (constants.TaskState, string, error) {
+	return constants.Complete, "id: " + taskID, m.returnError
+}
+
+func (m *volumeManagerTester) Name() string {
+	return "foo"
+}
+
+func TestVolumeManagerFromInterfaces(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		returnError       error
+		badImplementation bool
+	}{
+		{"success with metadata", nil, false},
+		{"failure with metadata", errors.New("volume error"), false},
+		{"failure with bad implementation", bmclibErrs.ErrProviderImplementation, true},
+	}
+
+	spec := VolumeSpec{Controller: "RAID.Integrated.1-1", Name: "os", RAIDType: "RAID1", Drives: []string{"Disk.Bay.0", "Disk.Bay.1"}}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			tester := &volumeManagerTester{returnError: tc.returnError}
+
+			var generic []interface{}
+			if tc.badImplementation {
+				badImplementation := struct{}{}
+				generic = []interface{}{&badImplementation}
+			} else {
+				generic = []interface{}{tester}
+			}
+
+			ctx := context.Background()
+
+			taskID, metadata, err := CreateVolumeFromInterfaces(ctx, spec, generic)
+			if tc.returnError != nil {
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, _, err = VolumesFromInterfaces(ctx, generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, _, err = DeleteVolumeFromInterfaces(ctx, "RAID.Integrated.1-1", "os", generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				_, _, err = SetStorageControllerModeFromInterfaces(ctx, "RAID.Integrated.1-1", StorageControllerHBA, generic)
+				assert.ErrorIs(t, err, tc.returnError)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "JID_123", taskID)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+
+			volumes, metadata, err := VolumesFromInterfaces(ctx, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "foo", metadata.SuccessfulProvider)
+			assert.Equal(t, []Volume{{ID: "os", Name: "os", Controller: "RAID.Integrated.1-1", RAIDType: "RAID1", Drives: []string{"Disk.Bay.0", "Disk.Bay.1"}}}, volumes)
+
+			taskID, _, err = InitializeVolumeFromInterfaces(ctx, "RAID.Integrated.1-1", "os", true, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "JID_125", taskID)
+
+			taskID, _, err = DeleteVolumeFromInterfaces(ctx, "RAID.Integrated.1-1", "os", generic)
+			assert.Nil(t, err)
+			assert.Equal(t, "JID_124", taskID)
+			assert.Empty(t, tester.volumes)
+
+			_, _, err = SetStorageControllerModeFromInterfaces(ctx, "RAID.Integrated.1-1", StorageControllerHBA, generic)
+			assert.Nil(t, err)
+			assert.Equal(t, StorageControllerHBA, tester.mode)
+
+			state, _, _, err := VolumeTaskStatusFromInterfaces(ctx, "JID_123", generic)
+			assert.Nil(t, err)
+			assert.Equal(t, constants.Complete, state)
+		})
+	}
+}
+
+func TestVolumeSpecValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec VolumeSpec
+		err  error
+	}{
+		{"valid", VolumeSpec{Controller: "RAID.Integrated.1-1", RAIDType: "RAID5", Drives: []string{"0", "1", "2"}}, nil},
+		{"no controller", VolumeSpec{RAIDType: "RAID0", Drives: []string{"0"}}, bmclibErrs.ErrVolume},
+		{"unsupported RAID type", VolumeSpec{Controller: "RAID.Integrated.1-1", RAIDType: "RAID3", Drives: []string{"0", "1", "2"}}, bmclibErrs.ErrVolume},
+		{"too few drives", VolumeSpec{Controller: "RAID.Integrated.1-1", RAIDType: "RAID6", Drives: []string{"0", "1", "2"}}, bmclibErrs.ErrVolume},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.Validate()
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
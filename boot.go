@@ -0,0 +1,281 @@
+package bmclib
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	defaultBootTimeout      = 15 * time.Minute
+	defaultBootPollInterval = 10 * time.Second
+	defaultBootStallTimeout = 5 * time.Minute
+)
+
+// BootEventSource identifies the method a BootEvent was observed through.
+type BootEventSource string
+
+const (
+	BootEventSourcePower        BootEventSource = "power"
+	BootEventSourcePostCode     BootEventSource = "postcode"
+	BootEventSourceBootProgress BootEventSource = "bootprogress"
+)
+
+// BootEvent is a change in the host boot state observed by WaitForBoot.
+type BootEvent struct {
+	Time   time.Time
+	Source BootEventSource
+	Stage  bmc.BootProgressStage
+	// State is the power state, POST code status or boot progress state as returned by the provider.
+	State string
+	// Code is the POST code, this is only set for BootEventSourcePostCode events.
+	Code int
+}
+
+// BootTimeline is the record of the host boot returned by WaitForBoot.
+type BootTimeline struct {
+	Start time.Time
+	End   time.Time
+	// Stage is the last boot stage observed.
+	Stage  bmc.BootProgressStage
+	Events []BootEvent
+	// Screenshot is the screen capture taken when WaitForBootOptions.Screenshot is set and the boot timed out or stalled.
+	Screenshot     []byte
+	ScreenshotType string
+}
+
+// WaitForBootOptions are the parameters for WaitForBoot.
+type WaitForBootOptions struct {
+	// Stage is the boot stage to wait for, defaults to bmc.BootProgressStageOSBootStarted
+	// which is the latest stage identified through both the POST codes and the boot progress.
+	Stage bmc.BootProgressStage
+	// Timeout is the maximum time to wait for the host to boot, defaults to 15 minutes.
+	Timeout time.Duration
+	// PollInterval is the interval between queries to the BMC, defaults to 10 seconds.
+	PollInterval time.Duration
+	// StallTimeout is the time the POST code and boot progress may remain unchanged
+	// before the boot is considered stalled, defaults to 5 minutes.
+	StallTimeout time.Duration
+	// Screenshot captures the screen when the boot times out or stalls.
+	Screenshot bool
+}
+
+func (o *WaitForBootOptions) setDefaults() {
+	if o.Stage == "" {
+		o.Stage = bmc.BootProgressStageOSBootStarted
+	}
+
+	if o.Timeout == 0 {
+		o.Timeout = defaultBootTimeout
+	}
+
+	if o.PollInterval == 0 {
+		o.PollInterval = defaultBootPollInterval
+	}
+
+	if o.StallTimeout == 0 {
+		o.StallTimeout = defaultBootStallTimeout
+	}
+}
+
+// bootStageOrder orders the boot stages to identify when a stage was reached or passed,
+// the OEM and unknown stages are not ordered.
+var bootStageOrder = map[bmc.BootProgressStage]int{
+	bmc.BootProgressStageNone:          0,
+	bmc.BootProgressStagePOST:          1,
+	bmc.BootProgressStageSetup:         2,
+	bmc.BootProgressStagePOSTComplete:  2,
+	bmc.BootProgressStageOSBootStarted: 3,
+	bmc.BootProgressStageOSRunning:     4,
+}
+
+// bootStageReached returns true when the stage is the same or later than the target stage.
+func bootStageReached(stage, target bmc.BootProgressStage) bool {
+	s, ok := bootStageOrder[stage]
+	if !ok {
+		return false
+	}
+
+	t, ok := bootStageOrder[target]
+	if !ok {
+		return false
+	}
+
+	return s >= t
+}
+
+// WaitForBoot polls the power state, POST code and boot progress until the host reaches the boot stage in opts,
+// the boot stalls or the timeout expires.
+//
+// The POST code and boot progress are only queried when implemented by the registered providers,
+// and a BootTimeline of the observed changes is returned in all cases.
+func (c *Client) WaitForBoot(ctx context.Context, opts WaitForBootOptions) (*BootTimeline, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "WaitForBoot")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("host", c.Auth.Host))
+
+	opts.setDefaults()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	w := &bootWatcher{
+		client:                c,
+		postCodeSupported:     true,
+		bootProgressSupported: true,
+		last:                  map[BootEventSource]BootEvent{},
+		lastChange:            time.Now(),
+		timeline: &BootTimeline{
+			Start: time.Now(),
+			Stage: bmc.BootProgressStageUnknown,
+		},
+	}
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		stage, err := w.poll(ctx)
+		if err != nil {
+			return w.finish(ctx, opts, err)
+		}
+
+		if stage != bmc.BootProgressStageUnknown {
+			w.timeline.Stage = stage
+		}
+
+		if bootStageReached(stage, opts.Stage) {
+			return w.finish(ctx, opts, nil)
+		}
+
+		if stalled := time.Since(w.lastChange); stalled >= opts.StallTimeout {
+			return w.finish(ctx, opts, fmt.Errorf("%w: no progress for %s at stage: %s", bmclibErrs.ErrBootStalled, stalled.Round(time.Second), w.timeline.Stage))
+		}
+
+		select {
+		case <-ctx.Done():
+			return w.finish(ctx, opts, fmt.Errorf("%w: %w, last stage: %s", bmclibErrs.ErrBootTimeout, ctx.Err(), w.timeline.Stage))
+		case <-ticker.C:
+		}
+	}
+}
+
+// bootWatcher holds the WaitForBoot polling state.
+type bootWatcher struct {
+	client   *Client
+	timeline *BootTimeline
+	// sources that are not implemented by the registered providers are not queried again.
+	postCodeSupported     bool
+	bootProgressSupported bool
+	// last is the last event recorded for each source.
+	last map[BootEventSource]BootEvent
+	// lastChange is the time the boot last made progress.
+	lastChange time.Time
+}
+
+// record adds the event to the timeline when it differs from the last event of the same source.
+func (w *bootWatcher) record(event BootEvent) bool {
+	if last, exists := w.last[event.Source]; exists && last.State == event.State && last.Code == event.Code {
+		return false
+	}
+
+	w.last[event.Source] = event
+	w.timeline.Events = append(w.timeline.Events, event)
+	w.lastChange = event.Time
+
+	return true
+}
+
+// unsupported returns true when the error indicates the query is not supported by the registered providers.
+func unsupported(err error) bool {
+	return errors.Is(err, bmclibErrs.ErrProviderImplementation) || errors.Is(err, bmclibErrs.ErrRedfishVersionIncompatible)
+}
+
+// poll queries the BMC and returns the current boot stage.
+func (w *bootWatcher) poll(ctx context.Context) (bmc.BootProgressStage, error) {
+	stage := bmc.BootProgressStageUnknown
+
+	powerState, err := w.client.GetPowerState(ctx)
+	if err != nil {
+		w.client.Logger.V(1).Info("wait for boot: power state query failed", "host", w.client.Auth.Host, "error", err.Error())
+	} else {
+		powerOff := strings.EqualFold(powerState, "off")
+
+		event := BootEvent{Time: time.Now(), Source: BootEventSourcePower, Stage: bmc.BootProgressStageUnknown, State: strings.ToLower(powerState)}
+		if powerOff {
+			event.Stage = bmc.BootProgressStageNone
+		}
+
+		w.record(event)
+
+		// the POST code and boot progress of a powered off host are stale
+		if powerOff {
+			w.lastChange = time.Now()
+			return bmc.BootProgressStageNone, nil
+		}
+	}
+
+	if w.postCodeSupported {
+		status, code, err := w.client.PostCode(ctx)
+		switch {
+		case err == nil:
+			event := BootEvent{Time: time.Now(), Source: BootEventSourcePostCode, Stage: bmc.PostCodeBootProgressStage(status), State: status, Code: code}
+			w.record(event)
+			stage = event.Stage
+		case unsupported(err):
+			w.postCodeSupported = false
+		default:
+			w.client.Logger.V(1).Info("wait for boot: POST code query failed", "host", w.client.Auth.Host, "error", err.Error())
+		}
+	}
+
+	if w.bootProgressSupported {
+		progress, err := w.client.GetBootProgress(ctx)
+		switch {
+		case err == nil:
+			w.record(BootEvent{Time: time.Now(), Source: BootEventSourceBootProgress, Stage: progress.Stage, State: progress.State})
+			// the boot progress is preferred over the POST code when its stage is identified
+			if _, ordered := bootStageOrder[progress.Stage]; ordered {
+				stage = progress.Stage
+			}
+		case unsupported(err):
+			w.bootProgressSupported = false
+		default:
+			w.client.Logger.V(1).Info("wait for boot: boot progress query failed", "host", w.client.Auth.Host, "error", err.Error())
+		}
+	}
+
+	if !w.postCodeSupported && !w.bootProgressSupported {
+		return stage, errors.Wrap(bmclibErrs.ErrProviderImplementation, "no PostCodeGetter or BootProgressGetter implementations found")
+	}
+
+	return stage, nil
+}
+
+// finish completes the timeline, capturing a screenshot if the boot failed and one was requested.
+func (w *bootWatcher) finish(ctx context.Context, opts WaitForBootOptions, err error) (*BootTimeline, error) {
+	if err != nil && opts.Screenshot && !errors.Is(err, bmclibErrs.ErrProviderImplementation) {
+		// the context may have expired with the boot timeout
+		sctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), defaultConnectTimeout)
+		defer cancel()
+
+		image, fileType, sErr := w.client.Screenshot(sctx)
+		if sErr != nil {
+			w.client.Logger.V(1).Info("wait for boot: screenshot failed", "host", w.client.Auth.Host, "error", sErr.Error())
+		} else {
+			w.timeline.Screenshot = image
+			w.timeline.ScreenshotType = fileType
+		}
+	}
+
+	w.timeline.End = time.Now()
+
+	return w.timeline, err
+}
@@ -0,0 +1,164 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/jacobweinstock/registrar"
+	"github.com/stretchr/testify/assert"
+)
+
+// bootTestProvider returns the next POST code and boot progress in its sequences on each query,
+// the last values are repeated once the sequence is exhausted.
+type bootTestProvider struct {
+	mu           sync.Mutex
+	postCodes    []string
+	bootProgress []bmc.BootProgressStage
+	screenshots  int
+}
+
+func (b *bootTestProvider) Name() string {
+	return "boottester"
+}
+
+func (b *bootTestProvider) PowerStateGet(ctx context.Context) (string, error) {
+	return "On", nil
+}
+
+func (b *bootTestProvider) PostCode(ctx context.Context) (string, int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := b.postCodes[0]
+	if len(b.postCodes) > 1 {
+		b.postCodes = b.postCodes[1:]
+	}
+
+	return status, len(b.postCodes), nil
+}
+
+func (b *bootTestProvider) GetBootProgress(ctx context.Context) (bmc.BootProgress, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.bootProgress) == 0 {
+		return bmc.BootProgress{}, bmclibErrs.ErrRedfishVersionIncompatible
+	}
+
+	stage := b.bootProgress[0]
+	if len(b.bootProgress) > 1 {
+		b.bootProgress = b.bootProgress[1:]
+	}
+
+	return bmc.BootProgress{Stage: stage, State: string(stage), Timestamp: time.Now()}, nil
+}
+
+func (b *bootTestProvider) Screenshot(ctx context.Context) ([]byte, string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.screenshots++
+
+	return []byte("image"), "png", nil
+}
+
+func TestWaitForBoot(t *testing.T) {
+	testcases := []struct {
+		name             string
+		provider         *bootTestProvider
+		opts             WaitForBootOptions
+		expectStage      bmc.BootProgressStage
+		expectErr        error
+		expectScreenshot bool
+	}{
+		{
+			"boot progress reaches os running",
+			&bootTestProvider{
+				postCodes:    []string{constants.POSTStateUEFI},
+				bootProgress: []bmc.BootProgressStage{bmc.BootProgressStagePOST, bmc.BootProgressStagePOSTComplete, bmc.BootProgressStageOSRunning},
+			},
+			WaitForBootOptions{Stage: bmc.BootProgressStageOSRunning},
+			bmc.BootProgressStageOSRunning,
+			nil,
+			false,
+		},
+		{
+			"POST code without boot progress",
+			&bootTestProvider{
+				postCodes: []string{constants.POSTStateBootINIT, constants.POSTStateUEFI, constants.POSTStateOS},
+			},
+			WaitForBootOptions{},
+			bmc.BootProgressStageOSBootStarted,
+			nil,
+			false,
+		},
+		{
+			"stalled in POST",
+			&bootTestProvider{
+				postCodes: []string{constants.POSTStateBootINIT, constants.POSTStateUEFI},
+			},
+			WaitForBootOptions{StallTimeout: 50 * time.Millisecond, Screenshot: true},
+			bmc.BootProgressStagePOSTComplete,
+			bmclibErrs.ErrBootStalled,
+			true,
+		},
+		{
+			"timeout",
+			&bootTestProvider{
+				postCodes: []string{constants.POSTStateBootINIT, constants.POSTStateUEFI},
+			},
+			WaitForBootOptions{Timeout: 50 * time.Millisecond},
+			bmc.BootProgressStagePOSTComplete,
+			bmclibErrs.ErrBootTimeout,
+			false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := registrar.NewRegistry()
+			registry.Register(tc.provider.Name(), "test", nil, nil, tc.provider)
+			cl := NewClient("", "", "", WithRegistry(registry))
+
+			tc.opts.PollInterval = 5 * time.Millisecond
+
+			timeline, err := cl.WaitForBoot(context.Background(), tc.opts)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, tc.expectStage, timeline.Stage)
+			assert.Equal(t, tc.expectScreenshot, tc.provider.screenshots == 1)
+			assert.Equal(t, tc.expectScreenshot, timeline.Screenshot != nil)
+			assert.False(t, timeline.End.Before(timeline.Start))
+
+			// the power on event is recorded first, followed by the POST code and boot progress changes
+			assert.Equal(t, BootEventSourcePower, timeline.Events[0].Source)
+			assert.Equal(t, "on", timeline.Events[0].State)
+		})
+	}
+}
+
+func TestWaitForBootUnsupported(t *testing.T) {
+	registry := registrar.NewRegistry()
+	registry.Register("tester", "test", nil, nil, &testProvider{Powerstate: "on"})
+	cl := NewClient("", "", "", WithRegistry(registry))
+
+	_, err := cl.WaitForBoot(context.Background(), WaitForBootOptions{PollInterval: time.Millisecond})
+	assert.True(t, errors.Is(err, bmclibErrs.ErrProviderImplementation))
+}
+
+func TestBootStageReached(t *testing.T) {
+	assert.True(t, bootStageReached(bmc.BootProgressStageOSRunning, bmc.BootProgressStageOSBootStarted))
+	assert.True(t, bootStageReached(bmc.BootProgressStageSetup, bmc.BootProgressStagePOSTComplete))
+	assert.False(t, bootStageReached(bmc.BootProgressStagePOST, bmc.BootProgressStageOSBootStarted))
+	assert.False(t, bootStageReached(bmc.BootProgressStageOEM, bmc.BootProgressStageNone))
+}
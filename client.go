@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -15,7 +14,10 @@ import (
 	"dario.cat/mergo"
 	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/firmware/validate"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
+	"github.com/bmc-toolbox/bmclib/v2/internal/progress"
 	"github.com/bmc-toolbox/bmclib/v2/providers/asrockrack"
 	"github.com/bmc-toolbox/bmclib/v2/providers/dell"
 	"github.com/bmc-toolbox/bmclib/v2/providers/homeassistant"
@@ -54,6 +56,8 @@ type Client struct {
 	oneTimeRegistryEnabled bool
 	providerConfig         providerConfig
 	traceprovider          oteltrace.TracerProvider
+	firmwareUploadProgress progress.Func
+	firmwareValidation     *validate.Expected
 }
 
 // Auth details for connecting to a BMC
@@ -392,6 +396,9 @@ func (c *Client) Open(ctx context.Context) error {
 		for _, em := range ifs {
 			if em == elem.DriverInterface {
 				elem.DriverInterface = em
+				if reporter, ok := em.(featuresReporter); ok {
+					elem.Features = reporter.Features()
+				}
 				reg = append(reg, elem)
 			}
 		}
@@ -401,6 +408,12 @@ func (c *Client) Open(ctx context.Context) error {
 	return nil
 }
 
+// featuresReporter is implemented by providers whose features depend on the connected BMC,
+// the registered features are replaced with the reported features once the provider is opened.
+type featuresReporter interface {
+	Features() registrar.Features
+}
+
 // Close pass through to library function
 func (c *Client) Close(ctx context.Context) (err error) {
 
@@ -506,6 +519,18 @@ func (c *Client) ReadUsers(ctx context.Context) (users []map[string]string, err
 	return users, err
 }
 
+// Users returns the BMC user accounts with the roles normalized across providers
+func (c *Client) Users(ctx context.Context) (users []bmc.User, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Users")
+	defer span.End()
+
+	users, metadata, err := bmc.UsersFromInterfaces(ctx, c.perProviderTimeout(ctx), c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return users, err
+}
+
 // GetBootDeviceOverride pass through to library function
 func (c *Client) GetBootDeviceOverride(ctx context.Context) (override bmc.BootDeviceOverride, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetBootDeviceOverride")
@@ -565,6 +590,43 @@ func (c *Client) DeactivateSOL(ctx context.Context) (err error) {
 	return err
 }
 
+// BiosAttributeRegistry pass through library function to get the BIOS attribute registry
+func (c *Client) BiosAttributeRegistry(ctx context.Context) (registry bmc.BiosAttributeRegistry, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "BiosAttributeRegistry")
+	defer span.End()
+
+	registry, metadata, err := bmc.GetBiosAttributeRegistryInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return registry, err
+}
+
+// GetPendingBiosConfiguration pass through library function to get the BIOS configuration changes staged to be applied on the next reset
+func (c *Client) GetPendingBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetPendingBiosConfiguration")
+	defer span.End()
+
+	biosConfig, metadata, err := bmc.GetPendingBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return biosConfig, err
+}
+
+// DiffBiosConfiguration returns the desired BIOS attributes that differ from the current and pending configuration,
+// both are read from the same provider and no attributes are pending when the provider doesn't read the pending configuration.
+func (c *Client) DiffBiosConfiguration(ctx context.Context, desired map[string]string) (diffs []bmc.BiosAttributeDiff, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DiffBiosConfiguration")
+	defer span.End()
+
+	diffs, metadata, err := bmc.DiffBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), desired)
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return diffs, err
+}
+
 // Inventory pass through library function to collect hardware and firmware inventory
 func (c *Client) Inventory(ctx context.Context) (device *common.Device, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Inventory")
@@ -586,17 +648,20 @@ func (c *Client) GetBiosConfiguration(ctx context.Context) (biosConfig map[strin
 	return biosConfig, err
 }
 
-func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
+// SetBiosConfiguration pass through library function to set BIOS attributes applied at the given apply time
+func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime bmc.BiosApplyTime) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBiosConfiguration")
 	defer span.End()
 
-	metadata, err := bmc.SetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), biosConfig)
+	metadata, err := bmc.SetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), biosConfig, applyTime)
 	c.setMetadata(metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return err
 }
 
+// SetBiosConfigurationFromFile pass through library function to set the BIOS configuration from a vendor config file,
+// or a vendor neutral YAML or JSON profile
 func (c *Client) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBiosConfigurationFromFile")
 	defer span.End()
@@ -608,11 +673,64 @@ func (c *Client) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (
 	return err
 }
 
-func (c *Client) ResetBiosConfiguration(ctx context.Context) (err error) {
+// ExportBiosConfigurationToFile pass through library function to export the BIOS configuration in the given file format,
+// the exported file is accepted by SetBiosConfigurationFromFile.
+//
+// The exported file lists the current BIOS values, setting it reverts the BIOS changes pending a reset.
+func (c *Client) ExportBiosConfigurationToFile(ctx context.Context, format bmc.BiosConfigurationFormat) (cfg string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ExportBiosConfigurationToFile")
+	defer span.End()
+
+	cfg, metadata, err := bmc.ExportBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), format)
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return cfg, err
+}
+
+// ExportSystemConfiguration pass through library function to export the system configuration profile of the target components
+func (c *Client) ExportSystemConfiguration(ctx context.Context, opts bmc.SystemConfigurationExportOptions) (cfg string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ExportSystemConfiguration")
+	defer span.End()
+
+	cfg, metadata, err := bmc.ExportSystemConfigurationFromInterfaces(ctx, c.registry().GetDriverInterfaces(), opts)
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return cfg, err
+}
+
+// ImportSystemConfiguration pass through library function to import a system configuration profile,
+// the returned job ID is passed to SystemConfigurationJobStatus to track the import
+func (c *Client) ImportSystemConfiguration(ctx context.Context, cfg string, opts bmc.SystemConfigurationImportOptions) (jobID string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ImportSystemConfiguration")
+	defer span.End()
+
+	jobID, metadata, err := bmc.ImportSystemConfigurationFromInterfaces(ctx, c.registry().GetDriverInterfaces(), cfg, opts)
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return jobID, err
+}
+
+// SystemConfigurationJobStatus pass through library function to return the status of a system configuration import job
+func (c *Client) SystemConfigurationJobStatus(ctx context.Context, jobID string) (state constants.TaskState, status string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SystemConfigurationJobStatus")
+	defer span.End()
+
+	state, status, metadata, err := bmc.SystemConfigurationJobStatusFromInterfaces(ctx, c.registry().GetDriverInterfaces(), jobID)
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return state, status, err
+}
+
+// ResetBiosConfiguration pass through library function to reset the BIOS configuration to defaults at the given apply time
+func (c *Client) ResetBiosConfiguration(ctx context.Context, applyTime bmc.BiosApplyTime) (err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ResetBiosConfiguration")
 	defer span.End()
 
-	metadata, err := bmc.ResetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces())
+	metadata, err := bmc.ResetBiosConfigurationInterfaces(ctx, c.registry().GetDriverInterfaces(), applyTime)
 	c.setMetadata(metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
@@ -624,7 +742,7 @@ func (c *Client) FirmwareInstall(ctx context.Context, component string, operatio
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstall")
 	defer span.End()
 
-	taskID, metadata, err := bmc.FirmwareInstallFromInterfaces(ctx, component, operationApplyTime, forceInstall, reader, c.registry().GetDriverInterfaces())
+	taskID, metadata, err := bmc.FirmwareInstallFromInterfaces(ctx, component, operationApplyTime, forceInstall, c.firmwareUploadReader(reader, 0), c.registry().GetDriverInterfaces())
 	c.setMetadata(metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
@@ -658,6 +776,222 @@ func (c *Client) PostCode(ctx context.Context) (status string, code int, err err
 	return status, code, err
 }
 
+// GetBootProgress pass through library function to return the host boot progress
+func (c *Client) GetBootProgress(ctx context.Context) (progress bmc.BootProgress, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetBootProgress")
+	defer span.End()
+
+	progress, metadata, err := bmc.GetBootProgressFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return progress, err
+}
+
+// CreateEventSubscription pass through library function to create a BMC event subscription
+func (c *Client) CreateEventSubscription(ctx context.Context, subscription bmc.EventSubscription) (id string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "CreateEventSubscription")
+	defer span.End()
+
+	id, metadata, err := bmc.CreateEventSubscriptionFromInterfaces(ctx, subscription, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return id, err
+}
+
+// EventSubscriptions pass through library function to list the BMC event subscriptions
+func (c *Client) EventSubscriptions(ctx context.Context) (subscriptions []bmc.EventSubscription, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "EventSubscriptions")
+	defer span.End()
+
+	subscriptions, metadata, err := bmc.EventSubscriptionsFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return subscriptions, err
+}
+
+// DeleteEventSubscription pass through library function to delete a BMC event subscription
+func (c *Client) DeleteEventSubscription(ctx context.Context, id string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DeleteEventSubscription")
+	defer span.End()
+
+	metadata, err := bmc.DeleteEventSubscriptionFromInterfaces(ctx, id, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// AlertDestinations pass through library function to get the BMC alert destinations
+func (c *Client) AlertDestinations(ctx context.Context) (destinations []bmc.AlertDestination, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "AlertDestinations")
+	defer span.End()
+
+	destinations, metadata, err := bmc.AlertDestinationsFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return destinations, err
+}
+
+// SetAlertDestination pass through library function to set a BMC alert destination
+func (c *Client) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetAlertDestination")
+	defer span.End()
+
+	metadata, err := bmc.SetAlertDestinationFromInterfaces(ctx, destination, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// AlertFilters pass through library function to get the BMC alert filters
+func (c *Client) AlertFilters(ctx context.Context) (filters []bmc.AlertFilter, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "AlertFilters")
+	defer span.End()
+
+	filters, metadata, err := bmc.AlertFiltersFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return filters, err
+}
+
+// SetAlertFilter pass through library function to set a BMC alert filter
+func (c *Client) SetAlertFilter(ctx context.Context, filter bmc.AlertFilter) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetAlertFilter")
+	defer span.End()
+
+	metadata, err := bmc.SetAlertFilterFromInterfaces(ctx, filter, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetBMCTime pass through library function to get the BMC time, time zone and NTP configuration
+func (c *Client) GetBMCTime(ctx context.Context) (bmcTime bmc.BMCTime, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetBMCTime")
+	defer span.End()
+
+	bmcTime, metadata, err := bmc.GetBMCTimeFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return bmcTime, err
+}
+
+// SetBMCTime pass through library function to set the BMC date and time
+func (c *Client) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBMCTime")
+	defer span.End()
+
+	metadata, err := bmc.SetBMCTimeFromInterfaces(ctx, dateTime, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// SetBMCTimeZone pass through library function to set the BMC time zone
+func (c *Client) SetBMCTimeZone(ctx context.Context, timeZone string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetBMCTimeZone")
+	defer span.End()
+
+	metadata, err := bmc.SetBMCTimeZoneFromInterfaces(ctx, timeZone, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// SetNTPServers pass through library function to set the BMC NTP configuration
+func (c *Client) SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetNTPServers")
+	defer span.End()
+
+	metadata, err := bmc.SetNTPServersFromInterfaces(ctx, enabled, servers, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetRemoteSyslog pass through library function to get the BMC remote syslog configuration
+func (c *Client) GetRemoteSyslog(ctx context.Context) (syslog bmc.RemoteSyslog, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetRemoteSyslog")
+	defer span.End()
+
+	syslog, metadata, err := bmc.GetRemoteSyslogFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return syslog, err
+}
+
+// SetRemoteSyslog pass through library function to set the BMC remote syslog configuration
+func (c *Client) SetRemoteSyslog(ctx context.Context, syslog bmc.RemoteSyslog) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetRemoteSyslog")
+	defer span.End()
+
+	metadata, err := bmc.SetRemoteSyslogFromInterfaces(ctx, syslog, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetDirectoryService pass through library function to get the BMC LDAP or Active Directory configuration
+func (c *Client) GetDirectoryService(ctx context.Context, serviceType bmc.DirectoryServiceType) (service bmc.DirectoryService, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetDirectoryService")
+	defer span.End()
+
+	service, metadata, err := bmc.GetDirectoryServiceFromInterfaces(ctx, serviceType, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return service, err
+}
+
+// SetDirectoryService pass through library function to set the BMC LDAP or Active Directory configuration
+func (c *Client) SetDirectoryService(ctx context.Context, service bmc.DirectoryService) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetDirectoryService")
+	defer span.End()
+
+	metadata, err := bmc.SetDirectoryServiceFromInterfaces(ctx, service, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetAccountPolicy pass through library function to get the BMC password, lockout and session timeout policy
+func (c *Client) GetAccountPolicy(ctx context.Context) (policy bmc.AccountPolicy, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetAccountPolicy")
+	defer span.End()
+
+	policy, metadata, err := bmc.GetAccountPolicyFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return policy, err
+}
+
+// SetAccountPolicy pass through library function to set the BMC password, lockout and session timeout policy
+func (c *Client) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetAccountPolicy")
+	defer span.End()
+
+	metadata, err := bmc.SetAccountPolicyFromInterfaces(ctx, policy, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
 func (c *Client) Screenshot(ctx context.Context) (image []byte, fileType string, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Screenshot")
 	defer span.End()
@@ -715,11 +1049,17 @@ func (c *Client) FirmwareInstallSteps(ctx context.Context, component string) (ac
 }
 
 // FirmwareUpload just uploads the firmware for install, it returns a task ID to verify the upload status.
-func (c *Client) FirmwareUpload(ctx context.Context, component string, file *os.File) (uploadVerifyTaskID string, err error) {
+//
+// The firmware payload is streamed from the reader, size is the payload size in bytes.
+func (c *Client) FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64, opts ...FirmwareUploadOption) (uploadVerifyTaskID string, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareUpload")
 	defer span.End()
 
-	uploadVerifyTaskID, metadata, err := bmc.FirmwareUploadFromInterfaces(ctx, component, file, c.Registry.GetDriverInterfaces())
+	if err := c.validateFirmware(component, reader, size, opts...); err != nil {
+		return "", err
+	}
+
+	uploadVerifyTaskID, metadata, err := bmc.FirmwareUploadFromInterfaces(ctx, component, c.firmwareUploadReader(reader, size), size, c.Registry.GetDriverInterfaces())
 	c.setMetadata(metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
@@ -750,17 +1090,96 @@ func (c *Client) FirmwareInstallUploaded(ctx context.Context, component, uploadV
 	return installTaskID, err
 }
 
-func (c *Client) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+// FirmwareInstallUploadAndInitiate uploads _and_ initiates the firmware install process.
+//
+// The firmware payload is streamed from the reader, size is the payload size in bytes.
+func (c *Client) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64, opts ...FirmwareUploadOption) (taskID string, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "FirmwareInstallUploadAndInitiate")
 	defer span.End()
 
-	taskID, metadata, err := bmc.FirmwareInstallUploadAndInitiateFromInterfaces(ctx, component, file, c.registry().GetDriverInterfaces())
+	if err := c.validateFirmware(component, reader, size, opts...); err != nil {
+		return "", err
+	}
+
+	taskID, metadata, err := bmc.FirmwareInstallUploadAndInitiateFromInterfaces(ctx, component, c.firmwareUploadReader(reader, size), size, c.registry().GetDriverInterfaces())
 	c.setMetadata(metadata)
 	metadata.RegisterSpanAttributes(c.Auth.Host, span)
 
 	return taskID, err
 }
 
+// firmwareUploadReader wraps the firmware payload reader to report upload progress when a progress func was set on the client.
+func (c *Client) firmwareUploadReader(reader io.Reader, size int64) io.Reader {
+	if c.firmwareUploadProgress == nil {
+		return reader
+	}
+
+	return progress.Wrap(reader, size, c.firmwareUploadProgress)
+}
+
+// validateFirmware validates the firmware image is for the component being installed when firmware validation was enabled on the client.
+//
+// The image is identified from its whole content and not just the header, so validation requires a reader implementing io.ReaderAt,
+// the image is read with ReadAt and so the offset the upload begins from is unchanged.
+func (c *Client) validateFirmware(component string, reader io.Reader, size int64, opts ...FirmwareUploadOption) error {
+	if c.firmwareValidation == nil {
+		return nil
+	}
+
+	expected := *c.firmwareValidation
+	if expected.Component != "" && !strings.EqualFold(expected.Component, component) {
+		return fmt.Errorf("%w: component %s does not match the expected component: %s", bmclibErrs.ErrFirmwareValidation, component, expected.Component)
+	}
+
+	expected.Component = component
+
+	clientVersion := expected.Version
+	for _, opt := range opts {
+		opt(&expected)
+	}
+
+	if clientVersion != "" && !strings.EqualFold(clientVersion, expected.Version) {
+		return fmt.Errorf("%w: version %s does not match the expected version: %s", bmclibErrs.ErrFirmwareValidation, expected.Version, clientVersion)
+	}
+
+	readerAt, ok := reader.(io.ReaderAt)
+	if !ok {
+		return fmt.Errorf("%w: firmware validation requires a reader implementing io.ReaderAt, got: %T", bmclibErrs.ErrFirmwareValidation, reader)
+	}
+
+	if err := firmwareSizeMatches(readerAt, size); err != nil {
+		return fmt.Errorf("%w: %w", bmclibErrs.ErrFirmwareValidation, err)
+	}
+
+	image, err := validate.Validate(readerAt, size, expected)
+	if err != nil {
+		return fmt.Errorf("%w: %w", bmclibErrs.ErrFirmwareValidation, err)
+	}
+
+	c.Logger.V(2).Info("firmware image validated", "component", component, "format", image.Format, "vendor", image.Vendor, "version", image.Version)
+
+	return nil
+}
+
+// firmwareSizeMatches returns an error when the image read from r is not exactly size bytes,
+// so a short image is not validated and uploaded as if it were complete.
+func firmwareSizeMatches(r io.ReaderAt, size int64) error {
+	if size <= 0 {
+		return fmt.Errorf("%w: %d", validate.ErrImageSize, size)
+	}
+
+	buf := make([]byte, 1)
+	if _, err := r.ReadAt(buf, size-1); err != nil {
+		return fmt.Errorf("%w: image is shorter than the declared size: %d", validate.ErrImageSize, size)
+	}
+
+	if n, _ := r.ReadAt(buf, size); n > 0 {
+		return fmt.Errorf("%w: image is longer than the declared size: %d", validate.ErrImageSize, size)
+	}
+
+	return nil
+}
+
 // GetSystemEventLog queries for the SEL and returns the entries in an opinionated format.
 func (c *Client) GetSystemEventLog(ctx context.Context) (entries bmc.SystemEventLogEntries, err error) {
 	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSystemEventLog")
@@ -791,3 +1210,223 @@ func (c *Client) SendNMI(ctx context.Context) error {
 
 	return err
 }
+
+// GetSecureBoot pass through library function to get the UEFI Secure Boot state
+func (c *Client) GetSecureBoot(ctx context.Context) (secureBoot bmc.SecureBoot, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetSecureBoot")
+	defer span.End()
+
+	secureBoot, metadata, err := bmc.GetSecureBootFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return secureBoot, err
+}
+
+// SetSecureBoot pass through library function to enable or disable UEFI Secure Boot from the next boot
+func (c *Client) SetSecureBoot(ctx context.Context, enabled bool) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetSecureBoot")
+	defer span.End()
+
+	metadata, err := bmc.SetSecureBootFromInterfaces(ctx, enabled, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// SetSecureBootMode pass through library function to set the UEFI Secure Boot mode, Setup or User mode
+func (c *Client) SetSecureBootMode(ctx context.Context, mode string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetSecureBootMode")
+	defer span.End()
+
+	metadata, err := bmc.SetSecureBootModeFromInterfaces(ctx, mode, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// ResetSecureBootKeys pass through library function to reset the UEFI Secure Boot keys to the defaults
+func (c *Client) ResetSecureBootKeys(ctx context.Context) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ResetSecureBootKeys")
+	defer span.End()
+
+	metadata, err := bmc.ResetSecureBootKeysFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// DeleteSecureBootKeys pass through library function to delete all UEFI Secure Boot keys, putting the host in Setup mode
+func (c *Client) DeleteSecureBootKeys(ctx context.Context) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DeleteSecureBootKeys")
+	defer span.End()
+
+	metadata, err := bmc.DeleteSecureBootKeysFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// SecureBootCertificates pass through library function to list the certificates in a UEFI Secure Boot key database, PK, KEK, db or dbx
+func (c *Client) SecureBootCertificates(ctx context.Context, database string) (certificates []bmc.SecureBootCertificate, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SecureBootCertificates")
+	defer span.End()
+
+	certificates, metadata, err := bmc.SecureBootCertificatesFromInterfaces(ctx, database, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return certificates, err
+}
+
+// ImportSecureBootCertificate pass through library function to enroll a PEM certificate in a UEFI Secure Boot key database, PK, KEK, db or dbx
+func (c *Client) ImportSecureBootCertificate(ctx context.Context, database, certificate string) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ImportSecureBootCertificate")
+	defer span.End()
+
+	metadata, err := bmc.ImportSecureBootCertificateFromInterfaces(ctx, database, certificate, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// GetTPM pass through library function to get the TPM state
+func (c *Client) GetTPM(ctx context.Context) (tpm bmc.TPM, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "GetTPM")
+	defer span.End()
+
+	tpm, metadata, err := bmc.GetTPMFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return tpm, err
+}
+
+// SetTPM pass through library function to enable or disable the TPM on the next host reset
+func (c *Client) SetTPM(ctx context.Context, enabled bool) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetTPM")
+	defer span.End()
+
+	metadata, err := bmc.SetTPMFromInterfaces(ctx, enabled, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// ClearTPM pass through library function to clear the TPM on the next host reset
+func (c *Client) ClearTPM(ctx context.Context) (err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ClearTPM")
+	defer span.End()
+
+	metadata, err := bmc.ClearTPMFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return err
+}
+
+// Volumes pass through library function to list the volumes configured on the storage controllers
+func (c *Client) Volumes(ctx context.Context) (volumes []bmc.Volume, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "Volumes")
+	defer span.End()
+
+	volumes, metadata, err := bmc.VolumesFromInterfaces(ctx, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return volumes, err
+}
+
+// CreateVolume pass through library function to create a volume on a storage controller,
+// the task ID is returned when the volume is not created right away
+func (c *Client) CreateVolume(ctx context.Context, spec bmc.VolumeSpec) (taskID string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "CreateVolume")
+	defer span.End()
+
+	taskID, metadata, err := bmc.CreateVolumeFromInterfaces(ctx, spec, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return taskID, err
+}
+
+// DeleteVolume pass through library function to delete a volume from a storage controller,
+// the task ID is returned when the volume is not deleted right away
+func (c *Client) DeleteVolume(ctx context.Context, controller, volumeID string) (taskID string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "DeleteVolume")
+	defer span.End()
+
+	taskID, metadata, err := bmc.DeleteVolumeFromInterfaces(ctx, controller, volumeID, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return taskID, err
+}
+
+// InitializeVolume pass through library function to erase the data on a volume,
+// the task ID is returned when the initialize is not done right away
+func (c *Client) InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (taskID string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "InitializeVolume")
+	defer span.End()
+
+	taskID, metadata, err := bmc.InitializeVolumeFromInterfaces(ctx, controller, volumeID, fast, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return taskID, err
+}
+
+// SetStorageControllerMode pass through library function to switch a storage controller between RAID and HBA mode
+func (c *Client) SetStorageControllerMode(ctx context.Context, controller string, mode bmc.StorageControllerMode) (taskID string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SetStorageControllerMode")
+	defer span.End()
+
+	taskID, metadata, err := bmc.SetStorageControllerModeFromInterfaces(ctx, controller, mode, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return taskID, err
+}
+
+// VolumeTaskStatus pass through library function to return the status of the task applying a volume change
+func (c *Client) VolumeTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "VolumeTaskStatus")
+	defer span.End()
+
+	state, status, metadata, err := bmc.VolumeTaskStatusFromInterfaces(ctx, taskID, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return state, status, err
+}
+
+// SecureErase pass through library function to securely erase drives and run the whole system erase,
+// system erase components are prefixed with bmc.EraseTargetSystemPrefix. The returned task IDs are polled with EraseTaskStatus.
+func (c *Client) SecureErase(ctx context.Context, targets []string) (taskIDs []string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "SecureErase")
+	defer span.End()
+
+	taskIDs, metadata, err := bmc.SecureEraseFromInterfaces(ctx, targets, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return taskIDs, err
+}
+
+// EraseTaskStatus pass through library function to return the status of a secure erase task
+func (c *Client) EraseTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "EraseTaskStatus")
+	defer span.End()
+
+	state, status, metadata, err := bmc.EraseTaskStatusFromInterfaces(ctx, taskID, c.registry().GetDriverInterfaces())
+	c.setMetadata(metadata)
+	metadata.RegisterSpanAttributes(c.Auth.Host, span)
+
+	return state, status, err
+}
@@ -1,10 +1,16 @@
 package bmclib
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/firmware/validate"
 	"github.com/bmc-toolbox/bmclib/v2/logging"
 	"github.com/google/go-cmp/cmp"
 	"github.com/jacobweinstock/registrar"
@@ -111,6 +117,120 @@ func TestWithRedfishBasicAuth(t *testing.T) {
 	}
 }
 
+func TestValidateFirmware(t *testing.T) {
+	bmcImage := append([]byte("ATENs_FW"), 0x01, 0x49)
+
+	tests := []struct {
+		name       string
+		opts       []Option
+		uploadOpts []FirmwareUploadOption
+		component  string
+		reader     func() (io.Reader, int64)
+		wantErr    error
+	}{
+		{
+			"validation disabled",
+			nil,
+			nil,
+			"bios",
+			func() (io.Reader, int64) { return strings.NewReader("hello world"), 11 },
+			nil,
+		},
+		{
+			"component matches",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			[]FirmwareUploadOption{ExpectFirmwareVersion("1.73")},
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			nil,
+		},
+		{
+			"version mismatch",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			[]FirmwareUploadOption{ExpectFirmwareVersion("1.74")},
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			validate.ErrVersionMismatch,
+		},
+		{
+			"component mismatch",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			nil,
+			"bios",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			validate.ErrComponentMismatch,
+		},
+		{
+			"streamed image",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			[]FirmwareUploadOption{ExpectFirmwareVersion("1.73")},
+			"bmc",
+			func() (io.Reader, int64) { return io.MultiReader(bytes.NewReader(bmcImage)), int64(len(bmcImage)) },
+			bmclibErrs.ErrFirmwareValidation,
+		},
+		{
+			"short image",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			nil,
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) + 1 },
+			validate.ErrImageSize,
+		},
+		{
+			"long image",
+			[]Option{WithFirmwareValidation(validate.Expected{})},
+			nil,
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) - 1 },
+			validate.ErrImageSize,
+		},
+		{
+			"expected component conflicts",
+			[]Option{WithFirmwareValidation(validate.Expected{Component: "bios"})},
+			nil,
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			bmclibErrs.ErrFirmwareValidation,
+		},
+		{
+			"expected version conflicts",
+			[]Option{WithFirmwareValidation(validate.Expected{Version: "1.73"})},
+			[]FirmwareUploadOption{ExpectFirmwareVersion("1.74")},
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			bmclibErrs.ErrFirmwareValidation,
+		},
+		{
+			"expected component and version",
+			[]Option{WithFirmwareValidation(validate.Expected{Component: "BMC", Version: "1.73"})},
+			nil,
+			"bmc",
+			func() (io.Reader, int64) { return bytes.NewReader(bmcImage), int64(len(bmcImage)) },
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := NewClient("127.0.0.1", "ADMIN", "ADMIN", tt.opts...)
+			reader, size := tt.reader()
+
+			err := cl.validateFirmware(tt.component, reader, size, tt.uploadOpts...)
+			if tt.wantErr == nil {
+				assert.Equal(t, nil, err)
+
+				// the image is read with ReadAt, the upload begins from the start
+				b, err := io.ReadAll(reader)
+				assert.Equal(t, nil, err)
+				assert.Equal(t, int64(len(b)), size)
+				return
+			}
+
+			assert.Equal(t, true, errors.Is(err, tt.wantErr))
+			assert.Equal(t, true, errors.Is(err, bmclibErrs.ErrFirmwareValidation))
+		})
+	}
+}
+
 func TestWithConnectionTimeout(t *testing.T) {
 	host := "127.0.0.1"
 	user := "ADMIN"
@@ -37,6 +37,8 @@ const (
 	OnReset OperationApplyTime = "OnReset"
 	// FirmwareOnStartUpdateRequest sets the firmware install to begin after the start request has been sent.
 	OnStartUpdateRequest OperationApplyTime = "OnStartUpdateRequest"
+	// AtMaintenanceWindowStart sets the change to be applied at the start of a maintenance window.
+	AtMaintenanceWindowStart OperationApplyTime = "AtMaintenanceWindowStart"
 
 	// TODO: rename FirmwareInstall* task status names to FirmwareTaskState and declare a type.
 
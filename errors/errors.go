@@ -42,6 +42,9 @@ var (
 	// ErrUserAccountUpdate is returned when the user account failed to be updated
 	ErrUserAccountUpdate = errors.New("user account attributes could not be updated")
 
+	// ErrUserReconcile is returned when the desired user accounts could not be reconciled
+	ErrUserReconcile = errors.New("user accounts could not be reconciled")
+
 	// ErrRedfishVersionIncompatible is returned when a given version of redfish doesn't support a feature
 	ErrRedfishVersionIncompatible = errors.New("operation not supported in this redfish version")
 
@@ -78,6 +81,12 @@ var (
 	// ErrFirmwareVerifyTask indicates a firmware verify task is in progress or did not complete successfully,
 	ErrFirmwareVerifyTask = errors.New("error firmware upload verify task")
 
+	// ErrFirmwareValidation is returned when a firmware image fails validation before its uploaded
+	ErrFirmwareValidation = errors.New("firmware image validation failed")
+
+	// ErrRedfishEventService is returned on redfish event service errors
+	ErrRedfishEventService = errors.New("redfish event service error")
+
 	// ErrRedfishUpdateService is returned on redfish update service errors
 	ErrRedfishUpdateService = errors.New("redfish update service error")
 
@@ -102,6 +111,18 @@ var (
 	// ErrNoBiosAttributes is returned when no bios attributes are available from the BMC.
 	ErrNoBiosAttributes = errors.New("no BIOS attributes available")
 
+	// ErrBiosApplyTime is returned when the BIOS configuration apply time is not supported.
+	ErrBiosApplyTime = errors.New("unsupported BIOS configuration apply time")
+
+	// ErrBiosAttributeRegistryNotFound is returned when the BMC does not publish the BIOS attribute registry.
+	ErrBiosAttributeRegistryNotFound = errors.New("BIOS attribute registry not found")
+
+	// ErrBiosAttributeInvalid is returned when a BIOS attribute value is not accepted by the BIOS attribute registry.
+	ErrBiosAttributeInvalid = errors.New("invalid BIOS attribute")
+
+	// ErrBiosConfigurationFormat is returned when a BIOS configuration file is not in a supported format.
+	ErrBiosConfigurationFormat = errors.New("unsupported BIOS configuration file format")
+
 	// ErrScreenshot is returned when screen capture fails.
 	ErrScreenshot = errors.New("error in capturing screen")
 
@@ -126,6 +147,39 @@ var (
 
 	// ErrBMCUpdating is returned when the BMC is going through an update and will not serve other queries.
 	ErrBMCUpdating = errors.New("a BMC firmware update is in progress")
+
+	// ErrBootTimeout is returned when the host did not reach the expected boot stage in time.
+	ErrBootTimeout = errors.New("timed out waiting for host boot")
+
+	// ErrBootStalled is returned when the host boot made no progress for the stall timeout.
+	ErrBootStalled = errors.New("host boot stalled")
+
+	// ErrBMCTime is returned when the BMC time, timezone or NTP configuration could not be read or set.
+	ErrBMCTime = errors.New("error in BMC time configuration")
+
+	// ErrRemoteSyslog is returned when the BMC remote syslog configuration could not be read or set.
+	ErrRemoteSyslog = errors.New("error in BMC remote syslog configuration")
+
+	// ErrDirectoryService is returned when the BMC LDAP or Active Directory configuration could not be read or set.
+	ErrDirectoryService = errors.New("error in BMC directory service configuration")
+
+	// ErrAccountPolicy is returned when the BMC account policy could not be read or set.
+	ErrAccountPolicy = errors.New("error in BMC account policy")
+
+	// ErrSystemConfiguration is returned when the system configuration profile could not be exported or imported.
+	ErrSystemConfiguration = errors.New("error in system configuration profile")
+
+	// ErrSecureBoot is returned when the UEFI Secure Boot configuration could not be read or set.
+	ErrSecureBoot = errors.New("error in secure boot configuration")
+
+	// ErrTPM is returned when the TPM state could not be read or changed.
+	ErrTPM = errors.New("error in TPM configuration")
+
+	// ErrVolume is returned when a storage volume or the storage controller mode could not be read or changed.
+	ErrVolume = errors.New("error in storage volume configuration")
+
+	// ErrSecureErase is returned when the drive or system erase could not be started.
+	ErrSecureErase = errors.New("error in secure erase")
 )
 
 type ErrUnsupportedHardware struct {
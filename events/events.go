@@ -0,0 +1,54 @@
+// Package events receives the events pushed by BMCs, either as Redfish EventService
+// subscription deliveries with the Handler, or over a Server-Sent Events stream with the SSEClient.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Event is the Redfish Event payload sent by a BMC.
+type Event struct {
+	ODataType string `json:"@odata.type"`
+	ID        string `json:"Id"`
+	Name      string `json:"Name"`
+	// Context is the client supplied string set on the event subscription.
+	Context string   `json:"Context"`
+	Events  []Record `json:"Events"`
+	// Source is the address of the BMC the event was received from.
+	Source string `json:"-"`
+}
+
+// Record is a single event record in the Event payload.
+type Record struct {
+	EventType      string `json:"EventType"`
+	EventID        string `json:"EventId"`
+	EventTimestamp string `json:"EventTimestamp"`
+	// Severity is deprecated in Redfish in favor of MessageSeverity.
+	Severity          string          `json:"Severity"`
+	MessageSeverity   string          `json:"MessageSeverity"`
+	Message           string          `json:"Message"`
+	MessageID         string          `json:"MessageId"`
+	MessageArgs       []string        `json:"MessageArgs"`
+	OriginOfCondition Link            `json:"OriginOfCondition"`
+	Oem               json.RawMessage `json:"Oem,omitempty"`
+}
+
+// Link is a Redfish resource reference.
+type Link struct {
+	ODataID string `json:"@odata.id"`
+}
+
+// Timestamp returns the parsed EventTimestamp, the zero time is returned when the timestamp is absent or not in the RFC3339 format.
+func (r *Record) Timestamp() time.Time {
+	ts, err := time.Parse(time.RFC3339, r.EventTimestamp)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return ts
+}
+
+// Func is invoked with each event received.
+type Func func(ctx context.Context, event *Event)
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// maxEventSize is the maximum size of an event payload accepted by the Handler.
+	maxEventSize = 1 << 20
+)
+
+// Handler is a http.Handler that decodes the Redfish events POSTed by BMCs to an event subscription destination.
+type Handler struct {
+	fn      Func
+	context string
+	log     logr.Logger
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*Handler)
+
+// WithContext sets the Handler to reject events with an event subscription Context that does not match.
+func WithContext(context string) HandlerOption {
+	return func(h *Handler) {
+		h.context = context
+	}
+}
+
+// WithLogger sets the Handler logger.
+func WithLogger(l logr.Logger) HandlerOption {
+	return func(h *Handler) {
+		h.log = l
+	}
+}
+
+// NewHandler returns a Handler that invokes fn with each event received.
+func NewHandler(fn Func, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		fn:  fn,
+		log: logr.Discard(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEventSize+1))
+	if err != nil {
+		h.log.V(1).Info("error reading event payload", "source", r.RemoteAddr, "error", err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	if len(body) > maxEventSize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+
+		return
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(body, event); err != nil {
+		h.log.V(1).Info("error decoding event payload", "source", r.RemoteAddr, "error", err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	if h.context != "" && event.Context != h.context {
+		h.log.V(1).Info("event context mismatch", "source", r.RemoteAddr, "context", event.Context)
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	event.Source = r.RemoteAddr
+
+	h.fn(r.Context(), event)
+
+	w.WriteHeader(http.StatusNoContent)
+}
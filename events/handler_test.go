@@ -0,0 +1,113 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testEvent = `{
+	"@odata.type": "#Event.v1_4_0.Event",
+	"Id": "1",
+	"Name": "Event Array",
+	"Context": "bmclib",
+	"Events": [
+		{
+			"EventType": "Alert",
+			"EventId": "2162",
+			"EventTimestamp": "2024-03-01T10:20:30-06:00",
+			"MessageSeverity": "Warning",
+			"Message": "The system inlet temperature is greater than the upper warning threshold.",
+			"MessageId": "iDRAC.2.8.TMP0120",
+			"MessageArgs": ["System Board Inlet Temp"],
+			"OriginOfCondition": {
+				"@odata.id": "/redfish/v1/Chassis/System.Embedded.1"
+			}
+		}
+	]
+}`
+
+func TestHandler(t *testing.T) {
+	testcases := []struct {
+		name         string
+		method       string
+		body         string
+		opts         []HandlerOption
+		expectStatus int
+		expectEvent  bool
+	}{
+		{
+			"event received",
+			http.MethodPost,
+			testEvent,
+			nil,
+			http.StatusNoContent,
+			true,
+		},
+		{
+			"event context match",
+			http.MethodPost,
+			testEvent,
+			[]HandlerOption{WithContext("bmclib")},
+			http.StatusNoContent,
+			true,
+		},
+		{
+			"event context mismatch",
+			http.MethodPost,
+			testEvent,
+			[]HandlerOption{WithContext("foo")},
+			http.StatusForbidden,
+			false,
+		},
+		{
+			"invalid payload",
+			http.MethodPost,
+			`{"Events": `,
+			nil,
+			http.StatusBadRequest,
+			false,
+		},
+		{
+			"method not allowed",
+			http.MethodGet,
+			"",
+			nil,
+			http.StatusMethodNotAllowed,
+			false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *Event
+
+			handler := NewHandler(func(_ context.Context, event *Event) {
+				got = event
+			}, tc.opts...)
+
+			req := httptest.NewRequest(tc.method, "/events", strings.NewReader(tc.body))
+			req.RemoteAddr = "192.0.2.10:443"
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectStatus, rec.Code)
+			if !tc.expectEvent {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NotNil(t, got)
+			assert.Equal(t, "192.0.2.10:443", got.Source)
+			assert.Equal(t, 1, len(got.Events))
+			assert.Equal(t, "iDRAC.2.8.TMP0120", got.Events[0].MessageID)
+			assert.Equal(t, "/redfish/v1/Chassis/System.Embedded.1", got.Events[0].OriginOfCondition.ODataID)
+			assert.Equal(t, 2024, got.Events[0].Timestamp().Year())
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxSSELineSize is the maximum size of a line in the event stream.
+	maxSSELineSize = 1 << 20
+)
+
+var (
+	// ErrStreamClosed is returned when the BMC closes the event stream.
+	ErrStreamClosed = errors.New("event stream closed")
+)
+
+// SSEClient receives events from the Redfish EventService Server-Sent Events stream.
+type SSEClient struct {
+	url        string
+	user       string
+	pass       string
+	httpClient *http.Client
+	log        logr.Logger
+}
+
+// SSEOption configures an SSEClient.
+type SSEOption func(*SSEClient)
+
+// WithSSEHTTPClient sets the http client for the event stream.
+//
+// The http client should not set a Timeout, since that would close the event stream.
+func WithSSEHTTPClient(c *http.Client) SSEOption {
+	return func(s *SSEClient) {
+		s.httpClient = c
+	}
+}
+
+// WithSSELogger sets the SSEClient logger.
+func WithSSELogger(l logr.Logger) SSEOption {
+	return func(s *SSEClient) {
+		s.log = l
+	}
+}
+
+// NewSSEClient returns an SSEClient for the event stream URL,
+// this is the BMC EventService ServerSentEventUri - for example https://bmc/redfish/v1/EventService/SSE.
+//
+// The URL may include a $filter query parameter to limit the events sent by the BMC.
+func NewSSEClient(streamURL, user, pass string, opts ...SSEOption) *SSEClient {
+	s := &SSEClient{
+		url:  streamURL,
+		user: user,
+		pass: pass,
+		log:  logr.Discard(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.httpClient == nil {
+		s.httpClient = httpclient.Build()
+		s.httpClient.Timeout = 0
+	}
+
+	return s
+}
+
+// Listen opens the event stream and invokes fn with each event received,
+// it returns when the context is canceled or the stream is closed.
+func (s *SSEClient) Listen(ctx context.Context, fn Func) error {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return errors.Wrap(err, "invalid event stream URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	req.SetBasicAuth(s.user, s.pass)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return errors.Wrap(err, "error opening event stream")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("event stream request returned unexpected status code: %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSSELineSize)
+
+	var data []string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// an empty line dispatches the event
+		if line == "" {
+			if len(data) > 0 {
+				s.dispatch(ctx, u.Host, strings.Join(data, "\n"), fn)
+				data = nil
+			}
+
+			continue
+		}
+
+		// comment, sent by some BMCs as a keepalive
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		// the id, event and retry fields are not required to decode Redfish events
+		if field == "data" {
+			data = append(data, value)
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "error reading event stream")
+	}
+
+	return ErrStreamClosed
+}
+
+func (s *SSEClient) dispatch(ctx context.Context, source, data string, fn Func) {
+	event := &Event{}
+	if err := json.Unmarshal([]byte(data), event); err != nil {
+		s.log.V(1).Info("error decoding event stream payload", "source", source, "error", err.Error())
+		return
+	}
+
+	event.Source = source
+
+	fn(ctx, event)
+}
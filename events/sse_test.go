@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSEClientListen(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "foo", user)
+		assert.Equal(t, "bar", pass)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+
+		// keepalive comment, an event split across data lines and an invalid payload
+		lines := strings.Split(testEvent, "\n")
+		fmt.Fprint(w, ": keepalive\n\n")
+		fmt.Fprint(w, "id: 1\n")
+		for _, line := range lines {
+			fmt.Fprintf(w, "data: %s\n", line)
+		}
+		fmt.Fprint(w, "\n")
+		fmt.Fprint(w, "data: {\"Events\": \n\n")
+		fmt.Fprint(w, "id: 2\ndata:"+strings.ReplaceAll(testEvent, "\n", "")+"\n\n")
+	}))
+	defer server.Close()
+
+	client := NewSSEClient(server.URL+"/redfish/v1/EventService/SSE", "foo", "bar", WithSSEHTTPClient(server.Client()))
+
+	var got []*Event
+
+	err := client.Listen(context.Background(), func(_ context.Context, event *Event) {
+		got = append(got, event)
+	})
+
+	assert.ErrorIs(t, err, ErrStreamClosed)
+	assert.Equal(t, 2, len(got))
+
+	for _, event := range got {
+		assert.Equal(t, "bmclib", event.Context)
+		assert.Equal(t, strings.TrimPrefix(server.URL, "https://"), event.Source)
+		assert.Equal(t, "Warning", event.Events[0].MessageSeverity)
+	}
+}
+
+func TestSSEClientListenStatus(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewSSEClient(server.URL, "foo", "bar", WithSSEHTTPClient(server.Client()))
+
+	err := client.Listen(context.Background(), func(_ context.Context, _ *Event) {})
+	assert.ErrorContains(t, err, "401")
+}
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	bmclib "github.com/bmc-toolbox/bmclib/v2"
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
 	"github.com/bmc-toolbox/bmclib/v2/providers"
 	logrusr "github.com/bombsimon/logrusr/v2"
 
@@ -25,8 +27,10 @@ func main() {
 	user := flag.String("user", "", "Username to login with")
 	pass := flag.String("password", "", "Username to login with")
 	host := flag.String("host", "", "BMC hostname to connect to")
-	mode := flag.String("mode", "get", "Mode [get,set,reset]")
+	mode := flag.String("mode", "get", "Mode [get,set,setfile,export,reset]")
 	dfile := flag.String("file", "", "Read data from file")
+	applyTime := flag.String("applytime", "", "Apply time for set and reset [Immediate,OnReset]")
+	format := flag.String("format", "yaml", "Export file format [yaml,json,scp-xml,scp-json]")
 
 	flag.Parse()
 
@@ -45,7 +49,8 @@ func main() {
 		providers.FeatureGetBiosConfiguration,
 		providers.FeatureSetBiosConfiguration,
 		providers.FeatureResetBiosConfiguration,
-		providers.FeatureSetBiosConfigurationFromFile)
+		providers.FeatureSetBiosConfigurationFromFile,
+		providers.FeatureExportBiosConfiguration)
 
 	err := client.Open(ctx)
 	if err != nil {
@@ -88,7 +93,7 @@ func main() {
 		fmt.Println("Attempting to set BIOS configuration:")
 		fmt.Printf("exampleConfig: %+v\n", exampleConfig)
 
-		err := client.SetBiosConfiguration(ctx, exampleConfig)
+		err := client.SetBiosConfiguration(ctx, exampleConfig, bmc.BiosApplyTime{ApplyTime: constants.OperationApplyTime(*applyTime)})
 		if err != nil {
 			l.Error(err)
 		}
@@ -104,8 +109,22 @@ func main() {
 		if err != nil {
 			l.Error(err)
 		}
+	case "export":
+		cfg, err := client.ExportBiosConfigurationToFile(ctx, bmc.BiosConfigurationFormat(*format))
+		if err != nil {
+			l.Fatal(err)
+		}
+
+		if *dfile == "" {
+			fmt.Println(cfg)
+			return
+		}
+
+		if err := os.WriteFile(*dfile, []byte(cfg), 0o600); err != nil {
+			l.Fatal(err)
+		}
 	case "reset":
-		err := client.ResetBiosConfiguration(ctx)
+		err := client.ResetBiosConfiguration(ctx, bmc.BiosApplyTime{ApplyTime: constants.OperationApplyTime(*applyTime)})
 		if err != nil {
 			l.Error(err)
 		}
@@ -0,0 +1,353 @@
+// Package validate identifies firmware images and validates them against the expected vendor, component and version,
+// so a wrong or corrupt image is rejected before it is uploaded to the BMC.
+package validate
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/bmc-toolbox/common"
+	"github.com/pkg/errors"
+)
+
+// Format identifies the kind of firmware image.
+type Format string
+
+const (
+	FormatUnknown        Format = "unknown"
+	FormatDellDUP        Format = "dell-dup"
+	FormatSupermicroBIOS Format = "supermicro-bios"
+	FormatSupermicroBMC  Format = "supermicro-bmc"
+	FormatAMICapsule     Format = "ami-capsule"
+	FormatOpenBMCTarball Format = "openbmc-tarball"
+
+	// VendorOpenBMC is the vendor set on OpenBMC images.
+	VendorOpenBMC = "openbmc"
+
+	// scanChunkSize is the size of each chunk read when searching an image for markers.
+	scanChunkSize = 1 << 20
+)
+
+var (
+	ErrImageSize         = errors.New("firmware image size invalid")
+	ErrUnknownFormat     = errors.New("firmware image format not identified")
+	ErrVendorMismatch    = errors.New("firmware image vendor mismatch")
+	ErrComponentMismatch = errors.New("firmware image component mismatch")
+	ErrVersionMismatch   = errors.New("firmware image version mismatch")
+
+	// the AMI Aptio capsule GUID 4A3CA68B-7723-48FB-803D-578CC1FEC44D in its on disk byte order.
+	amiCapsuleGUID = []byte{0x8b, 0xa6, 0x3c, 0x4a, 0x23, 0x77, 0xfb, 0x48, 0x80, 0x3d, 0x57, 0x8c, 0xc1, 0xfe, 0xc4, 0x4d}
+
+	// markers searched for in the image
+	markerATENFooter  = []byte("ATENs_FW")
+	markerSupermicro  = []byte("Supermicro")
+	markerSUPERMICRO  = []byte("SUPERMICRO")
+	markerDell        = []byte("Dell")
+	markerDellUTF16   = []byte("D\x00e\x00l\x00l\x00")
+	markerDellPackage = []byte("<SoftwareComponent")
+	markerUEFIVolume  = []byte("_FVH")
+
+	// attributes of the SoftwareComponent element in a Dell DUP package.xml
+	dellVendorVersion = regexp.MustCompile(`vendorVersion="([^"]+)"`)
+	dellCategory      = regexp.MustCompile(`<Category value="([A-Z]+)"`)
+)
+
+// Image is the identified firmware image.
+type Image struct {
+	// Format is the identified image format.
+	Format Format
+	// Vendor is the hardware vendor the image is built for, empty when it could not be determined.
+	Vendor string
+	// Component is the component slug the image installs on, empty when it could not be determined.
+	Component string
+	// Version is the firmware version embedded in the image, empty when it could not be determined.
+	Version string
+}
+
+// Expected are the firmware image attributes validated by Validate,
+// attributes that are empty are not validated.
+type Expected struct {
+	Vendor    string
+	Component string
+	Version   string
+
+	// AllowUnknown accepts images whose format could not be identified.
+	AllowUnknown bool
+}
+
+// Identify returns the format, vendor, component and version of the firmware image read from r.
+//
+// The image is read with ReadAt and so the read offset of readers like *os.File is left unchanged.
+func Identify(r io.ReaderAt, size int64) (*Image, error) {
+	if size <= 0 {
+		return nil, errors.Wrap(ErrImageSize, fmt.Sprintf("%d", size))
+	}
+
+	header := make([]byte, min(size, 512))
+	if _, err := r.ReadAt(header, 0); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "error reading firmware image header")
+	}
+
+	if isTarball(header) {
+		image, err := identifyOpenBMCTarball(r, size)
+		if err != nil {
+			return nil, err
+		}
+
+		if image != nil {
+			return image, nil
+		}
+	}
+
+	markers, err := scan(r, size,
+		markerATENFooter,
+		markerSupermicro,
+		markerSUPERMICRO,
+		markerDell,
+		markerDellUTF16,
+		markerDellPackage,
+		markerUEFIVolume,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	supermicro := markers.found(markerSupermicro) || markers.found(markerSUPERMICRO)
+
+	switch {
+	case bytes.HasPrefix(header, []byte("MZ")) && (markers.found(markerDell) || markers.found(markerDellUTF16)):
+		return identifyDellDUP(r, size, markers)
+
+	case bytes.HasPrefix(header, amiCapsuleGUID):
+		image := &Image{Format: FormatAMICapsule, Component: common.SlugBIOS}
+		if supermicro {
+			image.Vendor = common.VendorSupermicro
+		}
+
+		return image, nil
+
+	case markers.found(markerATENFooter):
+		return identifySupermicroBMC(r, size, markers[string(markerATENFooter)])
+
+	case supermicro && markers.found(markerUEFIVolume):
+		return &Image{Format: FormatSupermicroBIOS, Vendor: common.VendorSupermicro, Component: common.SlugBIOS}, nil
+	}
+
+	return &Image{Format: FormatUnknown}, nil
+}
+
+// Validate identifies the firmware image read from r and returns an error if it does not match the expected attributes.
+//
+// Attributes that could not be identified from the image are not validated.
+func Validate(r io.ReaderAt, size int64, expected Expected) (*Image, error) {
+	image, err := Identify(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if image.Format == FormatUnknown {
+		if expected.AllowUnknown {
+			return image, nil
+		}
+
+		return image, ErrUnknownFormat
+	}
+
+	if mismatch(expected.Vendor, image.Vendor) {
+		return image, errors.Wrap(ErrVendorMismatch, fmt.Sprintf("expected: %s, image: %s", expected.Vendor, image.Vendor))
+	}
+
+	if mismatch(expected.Component, image.Component) {
+		return image, errors.Wrap(ErrComponentMismatch, fmt.Sprintf("expected: %s, image: %s", expected.Component, image.Component))
+	}
+
+	if mismatch(expected.Version, image.Version) {
+		return image, errors.Wrap(ErrVersionMismatch, fmt.Sprintf("expected: %s, image: %s", expected.Version, image.Version))
+	}
+
+	return image, nil
+}
+
+func mismatch(expected, identified string) bool {
+	if expected == "" || identified == "" {
+		return false
+	}
+
+	return !strings.EqualFold(strings.TrimSpace(expected), strings.TrimSpace(identified))
+}
+
+// isTarball returns true for a gzip compressed or ustar archive header.
+func isTarball(header []byte) bool {
+	if bytes.HasPrefix(header, []byte{0x1f, 0x8b}) {
+		return true
+	}
+
+	return len(header) >= 262 && bytes.Equal(header[257:262], []byte("ustar"))
+}
+
+// identifyOpenBMCTarball returns the OpenBMC image identified from the MANIFEST in the tarball,
+// nil is returned if the tarball does not include a MANIFEST.
+func identifyOpenBMCTarball(r io.ReaderAt, size int64) (*Image, error) {
+	var reader io.Reader = io.NewSectionReader(r, 0, size)
+
+	header := make([]byte, 2)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, errors.Wrap(err, "error reading firmware image header")
+	}
+
+	if bytes.Equal(header, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading gzip compressed firmware image")
+		}
+		defer gz.Close()
+
+		reader = gz
+	}
+
+	tr := tar.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrap(err, "error reading firmware image tarball")
+		}
+
+		if strings.TrimPrefix(hdr.Name, "./") != "MANIFEST" {
+			continue
+		}
+
+		return parseOpenBMCManifest(tr)
+	}
+}
+
+// parseOpenBMCManifest parses the key=value pairs in the OpenBMC image MANIFEST.
+//
+//	purpose=xyz.openbmc_project.Software.Version.VersionPurpose.BMC
+//	version=2.14.0-dev
+func parseOpenBMCManifest(r io.Reader) (*Image, error) {
+	image := &Image{Format: FormatOpenBMCTarball, Vendor: VendorOpenBMC, Component: common.SlugBMC}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "version":
+			image.Version = value
+		case "purpose":
+			if strings.HasSuffix(value, ".Host") {
+				image.Component = common.SlugBIOS
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading OpenBMC image MANIFEST")
+	}
+
+	return image, nil
+}
+
+// identifyDellDUP returns the Dell Update Package image,
+// the version and component are read from the embedded package.xml when its present uncompressed.
+func identifyDellDUP(r io.ReaderAt, size int64, markers markerOffsets) (*Image, error) {
+	image := &Image{Format: FormatDellDUP, Vendor: common.VendorDell}
+
+	offset, found := markers[string(markerDellPackage)]
+	if !found {
+		return image, nil
+	}
+
+	buf := make([]byte, min(size-offset, 4096))
+	if _, err := r.ReadAt(buf, offset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "error reading Dell DUP package.xml")
+	}
+
+	if m := dellVendorVersion.FindSubmatch(buf); m != nil {
+		image.Version = string(m[1])
+	}
+
+	if m := dellCategory.FindSubmatch(buf); m != nil {
+		switch string(m[1]) {
+		case "BI":
+			image.Component = common.SlugBIOS
+		case "ES":
+			image.Component = common.SlugBMC
+		case "NI":
+			image.Component = common.SlugNIC
+		}
+	}
+
+	return image, nil
+}
+
+// identifySupermicroBMC returns the Supermicro BMC image,
+// the version is read from the major, minor bytes that follow the ATENs_FW footer signature.
+func identifySupermicroBMC(r io.ReaderAt, size, offset int64) (*Image, error) {
+	image := &Image{Format: FormatSupermicroBMC, Vendor: common.VendorSupermicro, Component: common.SlugBMC}
+
+	versionOffset := offset + int64(len(markerATENFooter))
+	if versionOffset+2 > size {
+		return image, nil
+	}
+
+	version := make([]byte, 2)
+	if _, err := r.ReadAt(version, versionOffset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "error reading Supermicro BMC image footer")
+	}
+
+	image.Version = fmt.Sprintf("%d.%02d", version[0], version[1])
+
+	return image, nil
+}
+
+// markerOffsets maps markers to the offset they were first found at.
+type markerOffsets map[string]int64
+
+func (m markerOffsets) found(marker []byte) bool {
+	_, ok := m[string(marker)]
+	return ok
+}
+
+// scan reads through the image and returns the offsets of the first occurrence of each marker.
+func scan(r io.ReaderAt, size int64, markers ...[]byte) (markerOffsets, error) {
+	var overlap int
+	for _, m := range markers {
+		overlap = max(overlap, len(m)-1)
+	}
+
+	found := markerOffsets{}
+	buf := make([]byte, scanChunkSize+overlap)
+
+	for offset := int64(0); offset < size && len(found) < len(markers); offset += scanChunkSize {
+		n, err := r.ReadAt(buf[:min(int64(len(buf)), size-offset)], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.Wrap(err, "error reading firmware image")
+		}
+
+		for _, m := range markers {
+			if _, exists := found[string(m)]; exists {
+				continue
+			}
+
+			if idx := bytes.Index(buf[:n], m); idx >= 0 {
+				found[string(m)] = offset + int64(idx)
+			}
+		}
+	}
+
+	return found, nil
+}
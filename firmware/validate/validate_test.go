@@ -0,0 +1,185 @@
+package validate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/bmc-toolbox/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func openBMCTarball(t *testing.T, manifest string, compress bool) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	files := map[string]string{"image-u-boot": "u-boot", "MANIFEST": manifest}
+	for _, name := range []string{"image-u-boot", "MANIFEST"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(files[name]))}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !compress {
+		return buf.Bytes()
+	}
+
+	gzBuf := new(bytes.Buffer)
+	gw := gzip.NewWriter(gzBuf)
+	if _, err := gw.Write(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return gzBuf.Bytes()
+}
+
+func image(parts ...[]byte) []byte {
+	return bytes.Join(parts, bytes.Repeat([]byte{0xff}, 64))
+}
+
+func TestIdentify(t *testing.T) {
+	bmcManifest := "purpose=xyz.openbmc_project.Software.Version.VersionPurpose.BMC\nversion=2.14.0-dev\nMachineName=romulus\n"
+	hostManifest := "purpose=xyz.openbmc_project.Software.Version.VersionPurpose.Host\nversion=1.2.3\n"
+
+	tests := map[string]struct {
+		data    []byte
+		size    int64
+		want    *Image
+		wantErr error
+	}{
+		"invalid size": {
+			data:    []byte{},
+			wantErr: ErrImageSize,
+		},
+		"unknown": {
+			data: []byte("hello world"),
+			want: &Image{Format: FormatUnknown},
+		},
+		"openbmc gzip tarball": {
+			data: openBMCTarball(t, bmcManifest, true),
+			want: &Image{Format: FormatOpenBMCTarball, Vendor: VendorOpenBMC, Component: common.SlugBMC, Version: "2.14.0-dev"},
+		},
+		"openbmc host tarball": {
+			data: openBMCTarball(t, hostManifest, false),
+			want: &Image{Format: FormatOpenBMCTarball, Vendor: VendorOpenBMC, Component: common.SlugBIOS, Version: "1.2.3"},
+		},
+		"dell dup": {
+			data: image(
+				[]byte("MZ"),
+				[]byte("C\x00o\x00m\x00p\x00a\x00n\x00y\x00N\x00a\x00m\x00e\x00D\x00e\x00l\x00l\x00"),
+				[]byte(`<SoftwareComponent schemaVersion="1.0" vendorVersion="2.19.1" dellVersion="2.19.1"><Category value="BI">`),
+			),
+			want: &Image{Format: FormatDellDUP, Vendor: common.VendorDell, Component: common.SlugBIOS, Version: "2.19.1"},
+		},
+		"dell dup without package.xml": {
+			data: image([]byte("MZ"), []byte("Dell Inc.")),
+			want: &Image{Format: FormatDellDUP, Vendor: common.VendorDell},
+		},
+		"ami capsule": {
+			data: image(amiCapsuleGUID, markerUEFIVolume),
+			want: &Image{Format: FormatAMICapsule, Component: common.SlugBIOS},
+		},
+		"supermicro ami capsule": {
+			data: image(amiCapsuleGUID, markerUEFIVolume, []byte("Supermicro X12DPT-B")),
+			want: &Image{Format: FormatAMICapsule, Vendor: common.VendorSupermicro, Component: common.SlugBIOS},
+		},
+		"supermicro bios": {
+			data: image([]byte{0x00}, markerUEFIVolume, []byte("SUPERMICRO")),
+			want: &Image{Format: FormatSupermicroBIOS, Vendor: common.VendorSupermicro, Component: common.SlugBIOS},
+		},
+		"supermicro bmc": {
+			data: image([]byte{0x00}, append([]byte("ATENs_FW"), 0x01, 0x49)),
+			want: &Image{Format: FormatSupermicroBMC, Vendor: common.VendorSupermicro, Component: common.SlugBMC, Version: "1.73"},
+		},
+		"marker across scan chunks": {
+			data: image(make([]byte, scanChunkSize-100), append([]byte("ATENs_FW"), 0x01, 0x02)),
+			want: &Image{Format: FormatSupermicroBMC, Vendor: common.VendorSupermicro, Component: common.SlugBMC, Version: "1.02"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Identify(bytes.NewReader(tc.data), int64(len(tc.data)))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	bmcImage := image([]byte{0x00}, append([]byte("ATENs_FW"), 0x01, 0x49))
+
+	tests := map[string]struct {
+		data     []byte
+		expected Expected
+		wantErr  error
+	}{
+		"match": {
+			data:     bmcImage,
+			expected: Expected{Vendor: "Supermicro", Component: "bmc", Version: "1.73"},
+		},
+		"nothing expected": {
+			data: bmcImage,
+		},
+		"vendor mismatch": {
+			data:     bmcImage,
+			expected: Expected{Vendor: common.VendorDell},
+			wantErr:  ErrVendorMismatch,
+		},
+		"component mismatch": {
+			data:     bmcImage,
+			expected: Expected{Component: common.SlugBIOS},
+			wantErr:  ErrComponentMismatch,
+		},
+		"version mismatch": {
+			data:     bmcImage,
+			expected: Expected{Version: "1.74"},
+			wantErr:  ErrVersionMismatch,
+		},
+		"version not identified": {
+			data:     image([]byte("MZ"), []byte("Dell Inc.")),
+			expected: Expected{Vendor: common.VendorDell, Version: "2.19.1"},
+		},
+		"unknown format": {
+			data:    []byte("hello world"),
+			wantErr: ErrUnknownFormat,
+		},
+		"unknown format allowed": {
+			data:     []byte("hello world"),
+			expected: Expected{AllowUnknown: true},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Validate(bytes.NewReader(tc.data), int64(len(tc.data)), tc.expected)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
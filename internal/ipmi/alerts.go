@@ -0,0 +1,297 @@
+package ipmi
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+)
+
+const (
+	// lanChannel is the LAN channel alerts are configured on.
+	lanChannel = "1"
+	// alertAddressUnset is the alert destination address of an unused destination.
+	alertAddressUnset = "0.0.0.0"
+
+	// PEF configuration parameters, see IPMI v2.0 spec section 30.
+	pefParamEventFilterCount = 0x05
+	pefParamEventFilterTable = 0x06
+	pefParamEventFilterData1 = 0x07
+
+	// pefFilterEnabled is the enable bit in the event filter configuration byte.
+	pefFilterEnabled = 0x80
+)
+
+var (
+	errAlertDestination = errors.New("alert destination error")
+	errAlertFilter      = errors.New("alert filter error")
+)
+
+// pefSeverities are the event filter severity values, see IPMI v2.0 spec table 17-2.
+var pefSeverities = map[byte]string{
+	0x00: "unspecified",
+	0x01: "monitor",
+	0x02: "information",
+	0x04: "ok",
+	0x08: "non-critical",
+	0x10: "critical",
+	0x20: "non-recoverable",
+}
+
+// AlertDestinations returns the LAN alert destinations, the volatile destination 0 is not included.
+func (i *Ipmi) AlertDestinations(ctx context.Context) (destinations []bmc.AlertDestination, err error) {
+	output, err := i.run(ctx, []string{"lan", "alert", "print", lanChannel})
+	if err != nil {
+		return nil, errors.Wrap(errAlertDestination, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	lanOutput, err := i.run(ctx, []string{"lan", "print", lanChannel})
+	if err != nil {
+		return nil, errors.Wrap(errAlertDestination, fmt.Sprintf("%v: %v", err, lanOutput))
+	}
+
+	community := parseSNMPCommunity(lanOutput)
+
+	destinations = parseLanAlerts(output)
+	for idx := range destinations {
+		destinations[idx].Community = community
+	}
+
+	return destinations, nil
+}
+
+// SetAlertDestination sets the LAN alert destination IP address and the SNMP community,
+// a disabled destination has its address cleared.
+//
+// The SNMP community is set on the LAN channel, and so applies to all destinations.
+func (i *Ipmi) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) (err error) {
+	if _, err := strconv.ParseUint(destination.ID, 10, 8); err != nil || destination.ID == "0" {
+		return errors.Wrap(errAlertDestination, "expected a non volatile destination number, got: "+destination.ID)
+	}
+
+	address := alertAddressUnset
+	if destination.Enabled {
+		if net.ParseIP(destination.Address) == nil {
+			return errors.Wrap(errAlertDestination, "expected an IP address, got: "+destination.Address)
+		}
+
+		address = destination.Address
+	}
+
+	commands := [][]string{
+		{"lan", "alert", "set", lanChannel, destination.ID, "ipaddr", address},
+		{"lan", "alert", "set", lanChannel, destination.ID, "type", "pet"},
+	}
+
+	if destination.Community != "" {
+		commands = append(commands, []string{"lan", "set", lanChannel, "snmp", destination.Community})
+	}
+
+	for _, command := range commands {
+		output, err := i.run(ctx, command)
+		if err != nil {
+			return errors.Wrap(errAlertDestination, fmt.Sprintf("%v: %v", err, output))
+		}
+	}
+
+	return nil
+}
+
+// AlertFilters returns the PEF event filters.
+func (i *Ipmi) AlertFilters(ctx context.Context) (filters []bmc.AlertFilter, err error) {
+	data, err := i.pefConfigParam(ctx, pefParamEventFilterCount, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 2 {
+		return nil, errors.Wrap(errAlertFilter, "unexpected event filter count response")
+	}
+
+	count := int(data[1] & 0x7f)
+	for n := 1; n <= count; n++ {
+		entry, err := i.pefConfigParam(ctx, pefParamEventFilterTable, byte(n))
+		if err != nil {
+			return nil, err
+		}
+
+		filter, err := parsePEFFilter(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// SetAlertFilter enables or disables a PEF event filter.
+func (i *Ipmi) SetAlertFilter(ctx context.Context, filter bmc.AlertFilter) (err error) {
+	n, err := strconv.ParseUint(filter.ID, 10, 8)
+	if err != nil || n == 0 {
+		return errors.Wrap(errAlertFilter, "expected an event filter number, got: "+filter.ID)
+	}
+
+	entry, err := i.pefConfigParam(ctx, pefParamEventFilterTable, byte(n))
+	if err != nil {
+		return err
+	}
+
+	if len(entry) < 3 {
+		return errors.Wrap(errAlertFilter, "unexpected event filter response")
+	}
+
+	config := entry[2] &^ pefFilterEnabled
+	if filter.Enabled {
+		config |= pefFilterEnabled
+	}
+
+	// Set PEF Configuration Parameters - Event Filter Table Data 1
+	output, err := i.run(ctx, []string{
+		"raw", "0x04", "0x12",
+		fmt.Sprintf("0x%02x", pefParamEventFilterData1),
+		fmt.Sprintf("0x%02x", n),
+		fmt.Sprintf("0x%02x", config),
+	})
+	if err != nil {
+		return errors.Wrap(errAlertFilter, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	return nil
+}
+
+// pefConfigParam returns the response data of a Get PEF Configuration Parameters request.
+func (i *Ipmi) pefConfigParam(ctx context.Context, param, selector byte) ([]byte, error) {
+	output, err := i.run(ctx, []string{
+		"raw", "0x04", "0x13",
+		fmt.Sprintf("0x%02x", param),
+		fmt.Sprintf("0x%02x", selector),
+		"0x00",
+	})
+	if err != nil {
+		return nil, errors.Wrap(errAlertFilter, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	data, err := parseRawBytes(output)
+	if err != nil {
+		return nil, errors.Wrap(errAlertFilter, err.Error())
+	}
+
+	return data, nil
+}
+
+// parseLanAlerts parses the output of 'lan alert print'.
+func parseLanAlerts(raw string) (destinations []bmc.AlertDestination) {
+	var current *bmc.AlertDestination
+
+	flush := func() {
+		if current != nil && current.ID != "0" {
+			destinations = append(destinations, *current)
+		}
+		current = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "Alert Destination":
+			flush()
+			current = &bmc.AlertDestination{ID: value}
+		case "Alert IP Address":
+			if current == nil {
+				continue
+			}
+
+			current.Address = value
+			current.Enabled = value != "" && value != alertAddressUnset
+		}
+	}
+
+	flush()
+
+	return destinations
+}
+
+// parseSNMPCommunity returns the SNMP community from the output of 'lan print'.
+func parseSNMPCommunity(raw string) string {
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if found && strings.TrimSpace(key) == "SNMP Community String" {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// parseRawBytes parses the hex bytes printed by 'raw' commands.
+func parseRawBytes(raw string) ([]byte, error) {
+	fields := strings.Fields(raw)
+	data := make([]byte, 0, len(fields))
+
+	for _, field := range fields {
+		b, err := strconv.ParseUint(field, 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected raw response byte: %s", field)
+		}
+
+		data = append(data, byte(b))
+	}
+
+	return data, nil
+}
+
+// parsePEFFilter parses the Event Filter Table response data,
+// the data begins with the parameter revision followed by the filter entry, see IPMI v2.0 spec table 17-2.
+func parsePEFFilter(data []byte) (bmc.AlertFilter, error) {
+	if len(data) < 11 {
+		return bmc.AlertFilter{}, errors.Wrap(errAlertFilter, "unexpected event filter response length: "+strconv.Itoa(len(data)))
+	}
+
+	var (
+		number     = data[1] & 0x7f
+		config     = data[2]
+		severity   = data[5]
+		sensorType = data[8]
+		sensorNum  = data[9]
+	)
+
+	describe := func(b byte) string {
+		if b == 0xff {
+			return "any"
+		}
+
+		return fmt.Sprintf("0x%02x", b)
+	}
+
+	severityName, ok := pefSeverities[severity]
+	if !ok {
+		severityName = fmt.Sprintf("0x%02x", severity)
+	}
+
+	return bmc.AlertFilter{
+		ID: strconv.Itoa(int(number)),
+		Description: fmt.Sprintf(
+			"sensor type: %s, sensor number: %s, severity: %s",
+			describe(sensorType),
+			describe(sensorNum),
+			severityName,
+		),
+		Enabled: config&pefFilterEnabled != 0,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package ipmi
+
+import (
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+const lanAlertPrintOutput = `Alert Destination	: 0
+Alert Acknowledge	: Unacknowledged
+Destination Type	: PET Trap
+Retry Interval		: 0
+Number of Retries	: 0
+Alert Gateway		: Default
+Alert IP Address	: 0.0.0.0
+Alert MAC Address	: 00:00:00:00:00:00
+
+Alert Destination	: 1
+Alert Acknowledge	: Unacknowledged
+Destination Type	: PET Trap
+Retry Interval		: 3
+Number of Retries	: 3
+Alert Gateway		: Default
+Alert IP Address	: 192.0.2.10
+Alert MAC Address	: 00:00:00:00:00:00
+
+Alert Destination	: 2
+Alert Acknowledge	: Unacknowledged
+Destination Type	: PET Trap
+Retry Interval		: 3
+Number of Retries	: 3
+Alert Gateway		: Default
+Alert IP Address	: 0.0.0.0
+Alert MAC Address	: 00:00:00:00:00:00
+`
+
+func TestParseLanAlerts(t *testing.T) {
+	expected := []bmc.AlertDestination{
+		{ID: "1", Address: "192.0.2.10", Enabled: true},
+		{ID: "2", Address: "0.0.0.0", Enabled: false},
+	}
+
+	assert.Equal(t, expected, parseLanAlerts(lanAlertPrintOutput))
+	assert.Nil(t, parseLanAlerts(""))
+}
+
+func TestParseSNMPCommunity(t *testing.T) {
+	output := `Set in Progress         : Set Complete
+Auth Type Support       : MD5
+SNMP Community String   : public
+IP Address Source       : DHCP Address
+`
+
+	assert.Equal(t, "public", parseSNMPCommunity(output))
+	assert.Equal(t, "", parseSNMPCommunity("IP Address Source       : DHCP Address"))
+}
+
+func TestParseRawBytes(t *testing.T) {
+	data, err := parseRawBytes(" 11 01 80 01\n 01 ff\n")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x11, 0x01, 0x80, 0x01, 0x01, 0xff}, data)
+
+	_, err = parseRawBytes("Unable to send RAW command")
+	assert.NotNil(t, err)
+}
+
+func TestParsePEFFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected bmc.AlertFilter
+		err      bool
+	}{
+		{
+			"enabled filter",
+			[]byte{0x11, 0x01, 0x80, 0x01, 0x01, 0x10, 0xff, 0xff, 0x01, 0xff, 0x01, 0x00},
+			bmc.AlertFilter{ID: "1", Description: "sensor type: 0x01, sensor number: any, severity: critical", Enabled: true},
+			false,
+		},
+		{
+			"disabled filter",
+			[]byte{0x11, 0x02, 0x00, 0x01, 0x01, 0x08, 0xff, 0xff, 0xff, 0xff, 0x01},
+			bmc.AlertFilter{ID: "2", Description: "sensor type: any, sensor number: any, severity: non-critical", Enabled: false},
+			false,
+		},
+		{
+			"short response",
+			[]byte{0x11, 0x01},
+			bmc.AlertFilter{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := parsePEFFilter(tc.data)
+			if tc.err {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, filter)
+		})
+	}
+}
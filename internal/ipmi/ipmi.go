@@ -357,26 +357,7 @@ func (i *Ipmi) ReadUsers(ctx context.Context) (users []map[string]string, err er
 		return users, errors.Wrap(err, "error getting user list")
 	}
 
-	header := map[int]string{}
-	firstLine := true
-	scanner := bufio.NewScanner(strings.NewReader(output))
-	for scanner.Scan() {
-		line := strings.Fields(scanner.Text())
-		if firstLine {
-			firstLine = false
-			for x := 0; x < 5; x++ {
-				header[x] = line[x]
-			}
-			continue
-		}
-		entry := map[string]string{}
-		if line[1] != "true" {
-			for x := 0; x < 5; x++ {
-				entry[header[x]] = line[x]
-			}
-			users = append(users, entry)
-		}
-	}
+	users, _ = parseUserList(output)
 
 	return users, err
 }
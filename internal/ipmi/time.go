@@ -0,0 +1,45 @@
+package ipmi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// selTimeLayout is the date time format of 'sel time get' and 'sel time set'.
+const selTimeLayout = "01/02/2006 15:04:05"
+
+var errSELTime = errors.New("SEL time error")
+
+// GetSELTime returns the BMC SEL clock time, the SEL clock has no time zone and is returned as UTC.
+func (i *Ipmi) GetSELTime(ctx context.Context) (dateTime time.Time, err error) {
+	output, err := i.run(ctx, []string{"sel", "time", "get"})
+	if err != nil {
+		return dateTime, errors.Wrap(errSELTime, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	return parseSELTime(output)
+}
+
+// SetSELTime sets the BMC SEL clock time in UTC.
+func (i *Ipmi) SetSELTime(ctx context.Context, dateTime time.Time) (err error) {
+	output, err := i.run(ctx, []string{"sel", "time", "set", dateTime.UTC().Format(selTimeLayout)})
+	if err != nil {
+		return errors.Wrap(errSELTime, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	return nil
+}
+
+// parseSELTime parses the output of 'sel time get'.
+func parseSELTime(raw string) (time.Time, error) {
+	dateTime, err := time.Parse(selTimeLayout, strings.TrimSpace(raw))
+	if err != nil {
+		return time.Time{}, errors.Wrap(errSELTime, "unexpected SEL time: "+strings.TrimSpace(raw))
+	}
+
+	return dateTime, nil
+}
@@ -0,0 +1,17 @@
+package ipmi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSELTime(t *testing.T) {
+	got, err := parseSELTime("10/18/2026 12:34:56\n")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2026, 10, 18, 12, 34, 56, 0, time.UTC), got)
+
+	_, err = parseSELTime("Get SEL Time command failed")
+	assert.ErrorIs(t, err, errSELTime)
+}
@@ -0,0 +1,321 @@
+package ipmi
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	// nullUserID is the reserved null user slot, see IPMI v2.0 spec section 13.5.
+	nullUserID = "1"
+	// privilegeNoAccess is the channel privilege limit of an account without access.
+	privilegeNoAccess = "15"
+	// passwordLength16 is the IPMI v1.5 password length,
+	// longer passwords are stored with the IPMI v2.0 20 byte password flag.
+	passwordLength16 = 16
+	passwordLength20 = 20
+)
+
+// userPrivileges are the IPMI channel privilege levels for the user roles, see IPMI v2.0 spec table 6-1.
+var userPrivileges = map[string]string{
+	"callback":      "1",
+	"user":          "2",
+	"readonly":      "2",
+	"operator":      "3",
+	"administrator": "4",
+	"none":          privilegeNoAccess,
+}
+
+// userPrivilege returns the IPMI privilege level for the role.
+func userPrivilege(role string) (string, error) {
+	privilege, exists := userPrivileges[strings.ToLower(role)]
+	if !exists {
+		return "", errors.Wrap(bmclibErrs.ErrInvalidUserRole, role)
+	}
+
+	return privilege, nil
+}
+
+// userListRows returns the 'user list' header, the user account rows and the IDs of the free user slots.
+//
+// The name column is blank for unused slots, these are identified by the Callin column value in place of the name.
+func userListRows(output string) (header []string, rows [][]string, free []string) {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.Fields(scanner.Text())
+		if len(line) < 5 {
+			continue
+		}
+
+		if header == nil {
+			header = line
+			continue
+		}
+
+		if line[1] == "true" || line[1] == "false" {
+			free = append(free, line[0])
+			continue
+		}
+
+		rows = append(rows, line)
+	}
+
+	return header, rows, free
+}
+
+// parseUserList parses the 'user list' output into the user accounts and the IDs of the free user slots.
+func parseUserList(output string) (users []map[string]string, free []string) {
+	header, rows, free := userListRows(output)
+
+	for _, row := range rows {
+		entry := map[string]string{}
+		for x := 0; x < 5; x++ {
+			entry[header[x]] = row[x]
+		}
+		users = append(users, entry)
+	}
+
+	return users, free
+}
+
+// parseUsers parses the 'user list' output for the channel into the user accounts.
+//
+// The columns are ID, Name, Callin, Link Auth, IPMI Msg and Channel Priv Limit, the privilege may span several words.
+// The list does not report the user enable state, an account is taken as enabled when IPMI messaging is allowed
+// with a privilege other than NO ACCESS, Users refines this with the channel access enable status.
+func parseUsers(output, channel string) (users []bmc.User) {
+	_, rows, _ := userListRows(output)
+
+	for _, row := range rows {
+		if len(row) < 6 {
+			continue
+		}
+
+		privilege := strings.Join(row[5:], " ")
+
+		users = append(users, bmc.User{
+			ID:                row[0],
+			Username:          row[1],
+			Role:              bmc.NormalizeUserRole(privilege),
+			Enabled:           row[4] == "true" && privilege != "NO ACCESS",
+			AccountTypes:      []string{"IPMI"},
+			ChannelPrivileges: map[string]string{channel: privilege},
+		})
+	}
+
+	return users
+}
+
+// parseChannelAccessEnabled returns the 'Enable Status' reported by 'channel getaccess' for the user,
+// found is false when the ipmitool version does not report it.
+func parseChannelAccessEnabled(output string) (enabled, found bool) {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || strings.TrimSpace(key) != "Enable Status" {
+			continue
+		}
+
+		return strings.TrimSpace(value) == "enabled", true
+	}
+
+	return false, false
+}
+
+// Users returns the user accounts with their LAN channel privilege and enable status.
+func (i *Ipmi) Users(ctx context.Context) (users []bmc.User, err error) {
+	output, err := i.run(ctx, []string{"user", "list", lanChannel})
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrRetrievingUserAccounts, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	users = parseUsers(output, lanChannel)
+
+	for idx := range users {
+		output, err := i.run(ctx, []string{"channel", "getaccess", lanChannel, users[idx].ID})
+		if err != nil {
+			return nil, errors.Wrap(bmclibErrs.ErrRetrievingUserAccounts, fmt.Sprintf("%v: %v", err, output))
+		}
+
+		if enabled, found := parseChannelAccessEnabled(output); found {
+			users[idx].Enabled = enabled && users[idx].ChannelPrivileges[lanChannel] != "NO ACCESS"
+		}
+	}
+
+	return users, nil
+}
+
+// userSlots returns the BMC user accounts and the IDs of the free user slots.
+func (i *Ipmi) userSlots(ctx context.Context) (users []map[string]string, free []string, err error) {
+	output, err := i.run(ctx, []string{"user", "list"})
+	if err != nil {
+		return nil, nil, errors.Wrap(bmclibErrs.ErrRetrievingUserAccounts, fmt.Sprintf("%v: %v", err, output))
+	}
+
+	users, free = parseUserList(output)
+
+	return users, free, nil
+}
+
+// userID returns the user slot ID of the user account.
+func (i *Ipmi) userID(ctx context.Context, user string) (string, error) {
+	users, _, err := i.userSlots(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range users {
+		if entry["Name"] == user {
+			return entry["ID"], nil
+		}
+	}
+
+	return "", errors.Wrap(bmclibErrs.ErrUserAccountNotFound, user)
+}
+
+// runUserCommands runs the user account commands in order, stopping at the first failure.
+func (i *Ipmi) runUserCommands(ctx context.Context, commands [][]string) error {
+	for _, command := range commands {
+		output, err := i.run(ctx, command)
+		if err != nil {
+			return errors.Wrap(bmclibErrs.ErrUserAccountUpdate, fmt.Sprintf("%s: %v: %v", strings.Join(command[:3], " "), err, output))
+		}
+	}
+
+	return nil
+}
+
+func validatePassword(pass string) error {
+	if len(pass) > passwordLength20 {
+		return errors.Wrap(bmclibErrs.ErrUserAccountUpdate, fmt.Sprintf("password exceeds %d bytes", passwordLength20))
+	}
+
+	return nil
+}
+
+func setPasswordCommand(id, pass string) []string {
+	if len(pass) > passwordLength16 {
+		return []string{"user", "set", "password", id, pass, "20"}
+	}
+
+	return []string{"user", "set", "password", id, pass, "16"}
+}
+
+func setPrivilegeCommands(id, privilege string) [][]string {
+	access := "on"
+	if privilege == privilegeNoAccess {
+		access = "off"
+	}
+
+	return [][]string{
+		{"user", "priv", id, privilege, lanChannel},
+		{"channel", "setaccess", lanChannel, id, "callin=" + access, "ipmi=" + access, "link=" + access, "privilege=" + privilege},
+	}
+}
+
+// CreateUser creates the user account in the first free user slot with the role privilege on the LAN channel.
+func (i *Ipmi) CreateUser(ctx context.Context, user, pass, role string) (err error) {
+	if user == "" || pass == "" || role == "" {
+		return bmclibErrs.ErrUserParamsRequired
+	}
+
+	privilege, err := userPrivilege(role)
+	if err != nil {
+		return err
+	}
+
+	if err := validatePassword(pass); err != nil {
+		return err
+	}
+
+	users, free, err := i.userSlots(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range users {
+		if entry["Name"] == user {
+			return errors.Wrap(bmclibErrs.ErrUserAccountExists, user)
+		}
+	}
+
+	var id string
+	for _, slot := range free {
+		if slot != nullUserID {
+			id = slot
+			break
+		}
+	}
+
+	if id == "" {
+		return bmclibErrs.ErrNoUserSlotsAvailable
+	}
+
+	commands := [][]string{{"user", "set", "name", id, user}, setPasswordCommand(id, pass)}
+	commands = append(commands, setPrivilegeCommands(id, privilege)...)
+	commands = append(commands, []string{"user", "enable", id})
+
+	return i.runUserCommands(ctx, commands)
+}
+
+// UpdateUser updates the user account password and role privilege, the password or role are left unchanged when empty.
+func (i *Ipmi) UpdateUser(ctx context.Context, user, pass, role string) (err error) {
+	if user == "" || (pass == "" && role == "") {
+		return bmclibErrs.ErrUserParamsRequired
+	}
+
+	if err := validatePassword(pass); err != nil {
+		return err
+	}
+
+	var privilege string
+	if role != "" {
+		if privilege, err = userPrivilege(role); err != nil {
+			return err
+		}
+	}
+
+	id, err := i.userID(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return i.runUserCommands(ctx, updateUserCommands(id, pass, privilege))
+}
+
+// updateUserCommands returns the commands to update the user password and privilege, the password or privilege are left unchanged when empty.
+func updateUserCommands(id, pass, privilege string) (commands [][]string) {
+	if pass != "" {
+		commands = append(commands, setPasswordCommand(id, pass))
+	}
+
+	if privilege != "" {
+		commands = append(commands, setPrivilegeCommands(id, privilege)...)
+	}
+
+	return commands
+}
+
+// DeleteUser disables the user account, revokes its LAN channel access and clears the name to free the user slot.
+func (i *Ipmi) DeleteUser(ctx context.Context, user string) (err error) {
+	if user == "" {
+		return bmclibErrs.ErrUserParamsRequired
+	}
+
+	id, err := i.userID(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	commands := [][]string{{"user", "disable", id}}
+	commands = append(commands, setPrivilegeCommands(id, privilegeNoAccess)...)
+	commands = append(commands, []string{"user", "set", "name", id, ""})
+
+	return i.runUserCommands(ctx, commands)
+}
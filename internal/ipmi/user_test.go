@@ -0,0 +1,125 @@
+package ipmi
+
+import (
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// userListOutput is the 'user list 1' output of ipmitool 1.8.18 from a Supermicro X11 BMC.
+const userListOutput = `ID  Name	     Callin  Link Auth	IPMI Msg   Channel Priv Limit
+1                    true    false      false      Unknown (0x00)
+2   ADMIN            false   false      true       ADMINISTRATOR
+3   monitor          true    true       true       USER
+4   olduser          true    false      false      NO ACCESS
+5                    true    false      false      NO ACCESS
+`
+
+// channelGetAccessOutput is the 'channel getaccess 1 4' output of ipmitool 1.8.18 for a disabled user.
+const channelGetAccessOutput = `Maximum User IDs     : 10
+Enabled User IDs     : 2
+
+User ID              : 4
+User Name            : olduser
+Fixed Name           : No
+Access Available     : call-in / callback
+Link Authentication  : disabled
+IPMI Messaging       : disabled
+Privilege Level      : NO ACCESS
+Enable Status        : disabled
+`
+
+func TestParseUserList(t *testing.T) {
+	expectedUsers := []map[string]string{
+		{"ID": "2", "Name": "ADMIN", "Callin": "false", "Link": "false", "Auth": "true"},
+		{"ID": "3", "Name": "monitor", "Callin": "true", "Link": "true", "Auth": "true"},
+		{"ID": "4", "Name": "olduser", "Callin": "true", "Link": "false", "Auth": "false"},
+	}
+
+	users, free := parseUserList(userListOutput)
+	assert.Equal(t, expectedUsers, users)
+	assert.Equal(t, []string{"1", "5"}, free)
+}
+
+func TestParseUsers(t *testing.T) {
+	expected := []bmc.User{
+		{
+			ID:                "2",
+			Username:          "ADMIN",
+			Role:              bmc.UserRoleAdministrator,
+			Enabled:           true,
+			AccountTypes:      []string{"IPMI"},
+			ChannelPrivileges: map[string]string{"1": "ADMINISTRATOR"},
+		},
+		{
+			ID:                "3",
+			Username:          "monitor",
+			Role:              bmc.UserRoleReadOnly,
+			Enabled:           true,
+			AccountTypes:      []string{"IPMI"},
+			ChannelPrivileges: map[string]string{"1": "USER"},
+		},
+		{
+			ID:                "4",
+			Username:          "olduser",
+			Role:              bmc.NormalizeUserRole("NO ACCESS"),
+			Enabled:           false,
+			AccountTypes:      []string{"IPMI"},
+			ChannelPrivileges: map[string]string{"1": "NO ACCESS"},
+		},
+	}
+
+	assert.Equal(t, expected, parseUsers(userListOutput, "1"))
+}
+
+func TestParseChannelAccessEnabled(t *testing.T) {
+	enabled, found := parseChannelAccessEnabled(channelGetAccessOutput)
+	assert.True(t, found)
+	assert.False(t, enabled)
+
+	// ipmitool releases before 1.8.18 do not report the enable status
+	_, found = parseChannelAccessEnabled("User ID              : 4\nPrivilege Level      : NO ACCESS\n")
+	assert.False(t, found)
+}
+
+func TestUserPrivilege(t *testing.T) {
+	testCases := []struct {
+		role      string
+		privilege string
+		err       error
+	}{
+		{"Administrator", "4", nil},
+		{"operator", "3", nil},
+		{"ReadOnly", "2", nil},
+		{"None", privilegeNoAccess, nil},
+		{"superuser", "", bmclibErrs.ErrInvalidUserRole},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.role, func(t *testing.T) {
+			privilege, err := userPrivilege(tc.role)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.privilege, privilege)
+		})
+	}
+}
+
+func TestSetPasswordCommand(t *testing.T) {
+	assert.Equal(t, []string{"user", "set", "password", "3", "sixteen-bytes-16", "16"}, setPasswordCommand("3", "sixteen-bytes-16"))
+	assert.Equal(t, []string{"user", "set", "password", "3", "twenty-bytes-long-20", "20"}, setPasswordCommand("3", "twenty-bytes-long-20"))
+	assert.ErrorIs(t, validatePassword("twenty-one-bytes-long"), bmclibErrs.ErrUserAccountUpdate)
+}
+
+func TestUpdateUserCommands(t *testing.T) {
+	// the password is left unchanged when empty
+	assert.Equal(t, setPrivilegeCommands("3", "OPERATOR"), updateUserCommands("3", "", "OPERATOR"))
+	assert.Equal(t, [][]string{setPasswordCommand("3", "calvin")}, updateUserCommands("3", "calvin", ""))
+	assert.Equal(t, append([][]string{setPasswordCommand("3", "calvin")}, setPrivilegeCommands("3", "OPERATOR")...), updateUserCommands("3", "calvin", "OPERATOR"))
+}
@@ -0,0 +1,132 @@
+// Package progress provides an io.Reader wrapper that reports the bytes read from the underlying reader.
+package progress
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// Func is invoked with the number of bytes read so far and the total expected size.
+type Func func(sent, total int64)
+
+// Reader wraps an io.Reader and invokes the progress Func on each read.
+type Reader struct {
+	reader io.Reader
+	total  int64
+	sent   atomic.Int64
+	fn     Func
+}
+
+// NewReader returns a Reader that reports progress for r to fn.
+//
+// total is the expected size of the payload and is passed through to fn as is.
+func NewReader(r io.Reader, total int64, fn Func) *Reader {
+	return &Reader{reader: r, total: total, fn: fn}
+}
+
+// Wrap returns a Reader that reports progress for r to fn, as NewReader does.
+//
+// The returned reader implements io.Seeker and io.ReaderAt when r does,
+// so callers that type assert for them behave the same with and without progress reporting.
+func Wrap(r io.Reader, total int64, fn Func) io.Reader {
+	reader := NewReader(r, total, fn)
+
+	seeker, isSeeker := r.(io.Seeker)
+	readerAt, isReaderAt := r.(io.ReaderAt)
+
+	switch {
+	case isSeeker && isReaderAt:
+		return &readSeekerAt{&readSeeker{reader, seeker}, readerAt}
+	case isSeeker:
+		return &readSeeker{reader, seeker}
+	case isReaderAt:
+		return &readerAtReader{reader, readerAt}
+	default:
+		return reader
+	}
+}
+
+// Read implements the io.Reader interface.
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		sent := r.sent.Add(int64(n))
+		if r.fn != nil {
+			r.fn(sent, r.total)
+		}
+	}
+
+	return n, err
+}
+
+// Sent returns the number of bytes read so far.
+func (r *Reader) Sent() int64 {
+	return r.sent.Load()
+}
+
+// Name returns the name of the underlying reader when it implements a Name() method, like *os.File does.
+//
+// This lets callers that name multipart form files after the reader continue to do so.
+func (r *Reader) Name() string {
+	if named, ok := r.reader.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+
+	return ""
+}
+
+// readSeeker is a Reader for an underlying io.Seeker.
+type readSeeker struct {
+	*Reader
+	seeker io.Seeker
+}
+
+// Seek implements the io.Seeker interface, the bytes read so far are set to the new offset.
+func (r *readSeeker) Seek(offset int64, whence int) (int64, error) {
+	n, err := r.seeker.Seek(offset, whence)
+	if err != nil {
+		return n, err
+	}
+
+	r.sent.Store(n)
+	if r.fn != nil {
+		r.fn(n, r.total)
+	}
+
+	return n, nil
+}
+
+// readerAtReader is a Reader for an underlying io.ReaderAt.
+type readerAtReader struct {
+	*Reader
+	readerAt io.ReaderAt
+}
+
+// ReadAt implements the io.ReaderAt interface, progress is reported up to the furthest offset read.
+func (r *readerAtReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.readerAt.ReadAt(p, off)
+
+	end := off + int64(n)
+	for sent := r.sent.Load(); end > sent; sent = r.sent.Load() {
+		if r.sent.CompareAndSwap(sent, end) {
+			if r.fn != nil {
+				r.fn(end, r.total)
+			}
+
+			break
+		}
+	}
+
+	return n, err
+}
+
+// readSeekerAt is a Reader for an underlying io.Seeker and io.ReaderAt.
+type readSeekerAt struct {
+	*readSeeker
+	readerAt io.ReaderAt
+}
+
+// ReadAt implements the io.ReaderAt interface, see readerAtReader.ReadAt.
+func (r *readSeekerAt) ReadAt(p []byte, off int64) (int, error) {
+	return (&readerAtReader{r.Reader, r.readerAt}).ReadAt(p, off)
+}
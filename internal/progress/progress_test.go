@@ -0,0 +1,98 @@
+package progress
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReader(t *testing.T) {
+	payload := bytes.Repeat([]byte("a"), 1024)
+
+	var calls int
+	var lastSent, lastTotal int64
+
+	r := NewReader(bytes.NewReader(payload), int64(len(payload)), func(sent, total int64) {
+		calls++
+		assert.GreaterOrEqual(t, sent, lastSent)
+		lastSent, lastTotal = sent, total
+	})
+
+	buf := make([]byte, 100)
+	var got []byte
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, payload, got)
+	assert.Equal(t, int64(len(payload)), lastSent)
+	assert.Equal(t, int64(len(payload)), lastTotal)
+	assert.Equal(t, int64(len(payload)), r.Sent())
+	assert.Equal(t, 11, calls)
+}
+
+func TestReaderName(t *testing.T) {
+	binPath := filepath.Join(t.TempDir(), "test.bin")
+	if err := os.WriteFile(binPath, []byte(`HELLOWORLD`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fh, err := os.Open(binPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	assert.Equal(t, binPath, NewReader(fh, 10, nil).Name())
+	assert.Equal(t, "", NewReader(bytes.NewReader(nil), 0, nil).Name())
+}
+
+func TestWrap(t *testing.T) {
+	payload := []byte(`HELLOWORLD`)
+
+	var lastSent int64
+	fn := func(sent, _ int64) { lastSent = sent }
+
+	r := Wrap(bytes.NewReader(payload), int64(len(payload)), fn)
+
+	seeker, ok := r.(io.Seeker)
+	assert.True(t, ok)
+
+	readerAt, ok := r.(io.ReaderAt)
+	assert.True(t, ok)
+
+	buf := make([]byte, 5)
+	n, err := readerAt.ReadAt(buf, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "WORLD", string(buf[:n]))
+	assert.Equal(t, int64(10), lastSent)
+
+	offset, err := seeker.Seek(2, io.SeekStart)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), offset)
+	assert.Equal(t, int64(2), lastSent)
+
+	got, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "LLOWORLD", string(got))
+	assert.Equal(t, int64(10), lastSent)
+
+	// readers without Seek or ReadAt are not given them
+	r = Wrap(io.MultiReader(bytes.NewReader(payload)), int64(len(payload)), fn)
+
+	_, ok = r.(io.Seeker)
+	assert.False(t, ok)
+
+	_, ok = r.(io.ReaderAt)
+	assert.False(t, ok)
+}
@@ -0,0 +1,115 @@
+package redfishwrapper
+
+import (
+	"context"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+// GetAccountPolicy returns the AccountService password and lockout policy and the SessionService session timeout,
+// the password complexity is a vendor extension and is not reported.
+func (c *Client) GetAccountPolicy(_ context.Context) (bmc.AccountPolicy, error) {
+	accountService, err := c.AccountService()
+	if err != nil {
+		return bmc.AccountPolicy{}, errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	minPasswordLength := int(accountService.MinPasswordLength)
+	lockoutCounterResetAfter := time.Duration(accountService.AccountLockoutCounterResetAfter) * time.Second
+
+	policy := bmc.AccountPolicy{
+		MinPasswordLength:        &minPasswordLength,
+		LockoutCounterResetAfter: &lockoutCounterResetAfter,
+	}
+
+	if accountService.AccountLockoutThreshold != nil {
+		lockoutThreshold := int(*accountService.AccountLockoutThreshold)
+		policy.LockoutThreshold = &lockoutThreshold
+	}
+
+	if accountService.AccountLockoutDuration != nil {
+		lockoutDuration := time.Duration(*accountService.AccountLockoutDuration) * time.Second
+		policy.LockoutDuration = &lockoutDuration
+	}
+
+	sessionService, err := c.SessionService()
+	if err != nil {
+		return bmc.AccountPolicy{}, errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	sessionTimeout := time.Duration(sessionService.SessionTimeout) * time.Second
+	policy.SessionTimeout = &sessionTimeout
+
+	return policy, nil
+}
+
+// SetAccountPolicy updates the AccountService password and lockout policy and the SessionService session timeout,
+// only the settings that are not nil are changed. The password complexity is a vendor extension
+// and ErrNotImplemented is returned when it is given.
+func (c *Client) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) error {
+	if policy.PasswordComplexity != nil {
+		return errors.Wrap(bmclibErrs.ErrNotImplemented, "password complexity is not supported by the redfish AccountService")
+	}
+
+	if negativeInt(policy.MinPasswordLength) || negativeInt(policy.LockoutThreshold) || negativeDuration(policy.LockoutDuration) ||
+		negativeDuration(policy.LockoutCounterResetAfter) || negativeDuration(policy.SessionTimeout) {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, "negative values are not valid")
+	}
+
+	payload := map[string]interface{}{}
+
+	if policy.MinPasswordLength != nil {
+		payload["MinPasswordLength"] = *policy.MinPasswordLength
+	}
+
+	if policy.LockoutThreshold != nil {
+		payload["AccountLockoutThreshold"] = *policy.LockoutThreshold
+	}
+
+	if policy.LockoutDuration != nil {
+		payload["AccountLockoutDuration"] = int(policy.LockoutDuration.Seconds())
+	}
+
+	if policy.LockoutCounterResetAfter != nil {
+		payload["AccountLockoutCounterResetAfter"] = int(policy.LockoutCounterResetAfter.Seconds())
+	}
+
+	if len(payload) > 0 {
+		accountService, err := c.AccountService()
+		if err != nil {
+			return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+		}
+
+		if err := c.patch(ctx, accountService.ODataID, payload); err != nil {
+			return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+		}
+	}
+
+	if policy.SessionTimeout == nil {
+		return nil
+	}
+
+	sessionService, err := c.SessionService()
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	payload = map[string]interface{}{"SessionTimeout": int(policy.SessionTimeout.Seconds())}
+
+	if err := c.patch(ctx, sessionService.ODataID, payload); err != nil {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	return nil
+}
+
+func negativeInt(v *int) bool {
+	return v != nil && *v < 0
+}
+
+func negativeDuration(v *time.Duration) bool {
+	return v != nil && *v < 0
+}
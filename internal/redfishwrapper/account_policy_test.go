@@ -0,0 +1,114 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func accountPolicyClient(t *testing.T, patched map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":               endpointFunc(t, "serviceroot.json"),
+		"/redfish/v1/AccountService": patchRecorder(t, "accounts/accountservice.json", patched),
+		"/redfish/v1/SessionService": patchRecorder(t, "sessionservice.json", patched),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestGetAccountPolicy(t *testing.T) {
+	client, closeFn := accountPolicyClient(t, map[string]map[string]interface{}{})
+	defer closeFn()
+
+	expect := bmc.AccountPolicy{
+		MinPasswordLength:        ptr(8),
+		LockoutThreshold:         ptr(3),
+		LockoutDuration:          ptr(time.Minute),
+		LockoutCounterResetAfter: ptr(30 * time.Second),
+		SessionTimeout:           ptr(30 * time.Minute),
+	}
+
+	got, err := client.GetAccountPolicy(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, expect, got)
+}
+
+func TestSetAccountPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy bmc.AccountPolicy
+		expect map[string]map[string]interface{}
+		err    error
+	}{
+		"all settings": {
+			policy: bmc.AccountPolicy{
+				MinPasswordLength:        ptr(12),
+				LockoutThreshold:         ptr(5),
+				LockoutDuration:          ptr(10 * time.Minute),
+				LockoutCounterResetAfter: ptr(5 * time.Minute),
+				SessionTimeout:           ptr(15 * time.Minute),
+			},
+			expect: map[string]map[string]interface{}{
+				"/redfish/v1/AccountService": {
+					"MinPasswordLength":               float64(12),
+					"AccountLockoutThreshold":         float64(5),
+					"AccountLockoutDuration":          float64(600),
+					"AccountLockoutCounterResetAfter": float64(300),
+				},
+				"/redfish/v1/SessionService": {"SessionTimeout": float64(900)},
+			},
+		},
+		"disable lockout": {
+			policy: bmc.AccountPolicy{LockoutThreshold: ptr(0)},
+			expect: map[string]map[string]interface{}{
+				"/redfish/v1/AccountService": {"AccountLockoutThreshold": float64(0)},
+			},
+		},
+		"password length only": {
+			policy: bmc.AccountPolicy{MinPasswordLength: ptr(14)},
+			expect: map[string]map[string]interface{}{
+				"/redfish/v1/AccountService": {"MinPasswordLength": float64(14)},
+			},
+		},
+		"nothing to change": {
+			policy: bmc.AccountPolicy{},
+			expect: map[string]map[string]interface{}{},
+		},
+		"password complexity": {
+			policy: bmc.AccountPolicy{PasswordComplexity: ptr(false)},
+			err:    bmclibErrs.ErrNotImplemented,
+		},
+		"negative value": {
+			policy: bmc.AccountPolicy{SessionTimeout: ptr(-time.Second)},
+			err:    bmclibErrs.ErrAccountPolicy,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]map[string]interface{}{}
+
+			client, closeFn := accountPolicyClient(t, patched)
+			defer closeFn()
+
+			err := client.SetAccountPolicy(context.TODO(), tc.policy)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
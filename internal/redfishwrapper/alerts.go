@@ -0,0 +1,127 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// snmpDestinationPayload is the redfish EventDestination create payload for SNMP trap destinations.
+type snmpDestinationPayload struct {
+	Destination      string              `json:"Destination"`
+	Protocol         string              `json:"Protocol"`
+	SubscriptionType string              `json:"SubscriptionType"`
+	Context          string              `json:"Context"`
+	SNMP             snmpSettingsPayload `json:"SNMP"`
+}
+
+type snmpSettingsPayload struct {
+	TrapCommunity string `json:"TrapCommunity,omitempty"`
+}
+
+// snmpProtocol returns true when the event destination protocol is SNMP.
+func snmpProtocol(protocol schemas.EventDestinationProtocol) bool {
+	switch protocol {
+	case schemas.SNMPv1EventDestinationProtocol,
+		schemas.SNMPv2cEventDestinationProtocol,
+		schemas.SNMPv3EventDestinationProtocol:
+		return true
+	}
+
+	return false
+}
+
+// AlertDestinations returns the SNMP trap EventService subscriptions as alert destinations.
+func (c *Client) AlertDestinations(_ context.Context) ([]bmc.AlertDestination, error) {
+	eventService, err := c.EventService()
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := eventService.Subscriptions()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+
+	destinations := []bmc.AlertDestination{}
+	for _, s := range subscriptions {
+		if !snmpProtocol(s.Protocol) {
+			continue
+		}
+
+		destinations = append(destinations, bmc.AlertDestination{
+			ID:        s.ODataID,
+			Address:   strings.TrimPrefix(s.Destination, "snmp://"),
+			Community: s.SNMP.TrapCommunity,
+			Enabled:   s.Status.State != schemas.DisabledState,
+		})
+	}
+
+	return destinations, nil
+}
+
+// SetAlertDestination adds or removes an SNMP trap EventService subscription.
+//
+// Redfish event destinations cannot be updated, a destination without an ID is added,
+// and a disabled destination is removed.
+func (c *Client) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) error {
+	switch {
+	case destination.ID == "" && destination.Enabled:
+		return c.createSNMPDestination(destination)
+	case destination.ID != "" && !destination.Enabled:
+		return c.DeleteEventSubscription(ctx, destination.ID)
+	case destination.ID != "":
+		return errors.Wrap(
+			bmclibErrs.ErrRedfishEventService,
+			"event destinations cannot be updated, disable the destination and add it without an ID: "+destination.ID,
+		)
+	default:
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, "expected an enabled destination or a destination ID")
+	}
+}
+
+func (c *Client) createSNMPDestination(destination bmc.AlertDestination) error {
+	target := "snmp://" + destination.Address
+	if u, err := url.Parse(target); err != nil || u.Hostname() == "" {
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, "invalid destination address: "+destination.Address)
+	}
+
+	eventService, err := c.EventService()
+	if err != nil {
+		return err
+	}
+
+	if eventService.SubscriptionsLink == "" {
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, "no subscriptions link in the event service")
+	}
+
+	payload := &snmpDestinationPayload{
+		Destination:      target,
+		Protocol:         string(schemas.SNMPv2cEventDestinationProtocol),
+		SubscriptionType: string(schemas.SNMPTrapSubscriptionType),
+		Context:          defaultEventSubscriptionContext,
+		SNMP:             snmpSettingsPayload{TrapCommunity: destination.Community},
+	}
+
+	resp, err := c.client.Post(eventService.SubscriptionsLink, payload)
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+
+	return resp.Body.Close()
+}
+
+// AlertFilters is not implemented, the redfish event destinations do not expose the BMC alert filters.
+func (c *Client) AlertFilters(_ context.Context) ([]bmc.AlertFilter, error) {
+	return nil, errors.Wrap(bmclibErrs.ErrNotImplemented, "alert filters not available over redfish")
+}
+
+// SetAlertFilter is not implemented, the redfish event destinations do not expose the BMC alert filters.
+func (c *Client) SetAlertFilter(_ context.Context, _ bmc.AlertFilter) error {
+	return errors.Wrap(bmclibErrs.ErrNotImplemented, "alert filters not available over redfish")
+}
@@ -0,0 +1,117 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func alertsClient(t *testing.T, subscriptionsHandler http.HandlerFunc) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                             endpointFunc(t, "serviceroot.json"),
+		"/redfish/v1/EventService":                 endpointFunc(t, "events/eventservice.json"),
+		"/redfish/v1/EventService/Subscriptions":   subscriptionsHandler,
+		"/redfish/v1/EventService/Subscriptions/1": endpointFunc(t, "events/subscriptions_1.json"),
+		"/redfish/v1/EventService/Subscriptions/2": endpointFunc(t, "alerts/subscriptions_2.json"),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestAlertDestinations(t *testing.T) {
+	client, closeFn := alertsClient(t, endpointFunc(t, "alerts/subscriptions.json"))
+	defer closeFn()
+
+	destinations, err := client.AlertDestinations(context.TODO())
+	assert.Nil(t, err)
+
+	expected := []bmc.AlertDestination{
+		{
+			ID:        "/redfish/v1/EventService/Subscriptions/2",
+			Address:   "192.0.2.20",
+			Community: "public",
+			Enabled:   true,
+		},
+	}
+
+	assert.Equal(t, expected, destinations)
+}
+
+func TestSetAlertDestination(t *testing.T) {
+	tests := map[string]struct {
+		destination   bmc.AlertDestination
+		expectPayload map[string]interface{}
+		err           error
+	}{
+		"add destination": {
+			destination: bmc.AlertDestination{Address: "192.0.2.30", Community: "private", Enabled: true},
+			expectPayload: map[string]interface{}{
+				"Destination":      "snmp://192.0.2.30",
+				"Protocol":         "SNMPv2c",
+				"SubscriptionType": "SNMPTrap",
+				"Context":          "bmclib",
+				"SNMP":             map[string]interface{}{"TrapCommunity": "private"},
+			},
+		},
+		"remove destination": {
+			destination: bmc.AlertDestination{ID: "/redfish/v1/EventService/Subscriptions/2"},
+		},
+		"update destination": {
+			destination: bmc.AlertDestination{ID: "/redfish/v1/EventService/Subscriptions/2", Address: "192.0.2.30", Enabled: true},
+			err:         bmclibErrs.ErrRedfishEventService,
+		},
+		"invalid address": {
+			destination: bmc.AlertDestination{Address: "", Enabled: true},
+			err:         bmclibErrs.ErrRedfishEventService,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subscriptionsHandler := func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					_, _ = w.Write(mustReadFile(t, "alerts/subscriptions.json"))
+					return
+				}
+
+				payload := map[string]interface{}{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(t, tc.expectPayload, payload)
+
+				w.Header().Set("Location", "/redfish/v1/EventService/Subscriptions/3")
+				w.WriteHeader(http.StatusCreated)
+			}
+
+			client, closeFn := alertsClient(t, subscriptionsHandler)
+			defer closeFn()
+
+			err := client.SetAlertDestination(context.TODO(), tc.destination)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestAlertFiltersNotImplemented(t *testing.T) {
+	client := NewClient("", "", "", "")
+
+	_, err := client.AlertFilters(context.TODO())
+	assert.ErrorIs(t, err, bmclibErrs.ErrNotImplemented)
+
+	err = client.SetAlertFilter(context.TODO(), bmc.AlertFilter{ID: "1"})
+	assert.ErrorIs(t, err, bmclibErrs.ErrNotImplemented)
+}
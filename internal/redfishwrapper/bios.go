@@ -2,11 +2,64 @@ package redfishwrapper
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
 	"github.com/stmcginnis/gofish/schemas"
 )
 
+// biosSettingsObject is the Bios resource link to the settings resource staging the attribute changes.
+type biosSettingsObject struct {
+	Settings struct {
+		SettingsObject struct {
+			ODataID string `json:"@odata.id"`
+		} `json:"SettingsObject"`
+	} `json:"@Redfish.Settings"`
+}
+
+// biosResetAction is the Bios resource ResetBios action.
+type biosResetAction struct {
+	Actions struct {
+		ResetBios struct {
+			Target string `json:"target"`
+		} `json:"#Bios.ResetBios"`
+	} `json:"Actions"`
+}
+
+// biosSettingsApplyTime is the settings apply time annotation sent along with the BIOS attributes.
+type biosSettingsApplyTime struct {
+	ApplyTime                          string `json:"ApplyTime"`
+	MaintenanceWindowStartTime         string `json:"MaintenanceWindowStartTime,omitempty"`
+	MaintenanceWindowDurationInSeconds int64  `json:"MaintenanceWindowDurationInSeconds,omitempty"`
+}
+
+// newBiosSettingsApplyTime returns the settings apply time annotation for the apply time,
+// the changes are applied on the next reset when no apply time is given.
+func newBiosSettingsApplyTime(applyTime bmc.BiosApplyTime) (biosSettingsApplyTime, error) {
+	settingsApplyTime := biosSettingsApplyTime{}
+
+	switch applyTime.ApplyTime {
+	case "", constants.OnReset:
+		settingsApplyTime.ApplyTime = string(schemas.OnResetSettingsApplyTime)
+	case constants.Immediate:
+		settingsApplyTime.ApplyTime = string(schemas.ImmediateSettingsApplyTime)
+	case constants.AtMaintenanceWindowStart:
+		settingsApplyTime.ApplyTime = string(schemas.AtMaintenanceWindowStartSettingsApplyTime)
+		settingsApplyTime.MaintenanceWindowStartTime = applyTime.MaintenanceWindowStart.Format(time.RFC3339)
+		settingsApplyTime.MaintenanceWindowDurationInSeconds = int64(applyTime.MaintenanceWindowDuration.Seconds())
+	default:
+		return settingsApplyTime, errors.Wrap(bmclibErrs.ErrBiosApplyTime, string(applyTime.ApplyTime))
+	}
+
+	return settingsApplyTime, nil
+}
+
+// GetBiosConfiguration returns the BIOS attributes along with the vendor neutral keys shared with the sum provider.
 func (c *Client) GetBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
 	sys, err := c.System()
 	if err != nil {
@@ -31,19 +84,86 @@ func (c *Client) GetBiosConfiguration(ctx context.Context) (biosConfig map[strin
 		biosConfig[attr] = bios.Attributes.String(attr)
 	}
 
-	return biosConfig, nil
+	return withNormalizedBiosAttributes(biosConfig, bios.Attributes), nil
 }
 
-func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
+// GetPendingBiosConfiguration returns the BIOS attribute changes staged in the Bios settings resource
+// along with the vendor neutral keys of the staged attributes,
+// no changes are returned when the Bios resource is updated directly without a settings resource.
+func (c *Client) GetPendingBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
 	sys, err := c.System()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	settingsAttributes := make(schemas.SettingsAttributes)
+	biosConfig = make(map[string]string)
+	if !c.compatibleOdataID(sys.ODataID, knownSystemsOdataIDs) {
+		return biosConfig, nil
+	}
 
+	bios, err := sys.Bios()
+	if err != nil {
+		return nil, err
+	}
+
+	if bios == nil {
+		return nil, bmclibErrs.ErrNoBiosAttributes
+	}
+
+	biosConfig, err = c.pendingBiosAttributes(bios)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(schemas.SettingsAttributes, len(biosConfig))
 	for attr, value := range biosConfig {
-		settingsAttributes[attr] = value
+		attributes[attr] = value
+	}
+
+	return withNormalizedBiosAttributes(biosConfig, attributes), nil
+}
+
+// pendingBiosAttributes returns the attribute values staged in the Bios settings resource that differ from the current values.
+func (c *Client) pendingBiosAttributes(bios *schemas.Bios) (map[string]string, error) {
+	biosConfig := make(map[string]string)
+
+	settings := &biosSettingsObject{}
+	if err := c.getResource(bios.ODataID, settings); err != nil {
+		return nil, err
+	}
+
+	if settings.Settings.SettingsObject.ODataID == "" {
+		return biosConfig, nil
+	}
+
+	pending := struct {
+		Attributes map[string]interface{} `json:"Attributes"`
+	}{}
+
+	if err := c.getResource(settings.Settings.SettingsObject.ODataID, &pending); err != nil {
+		return nil, err
+	}
+
+	// some BMCs list every attribute in the settings resource, only the values differing from the current values are pending
+	for attr, value := range pending.Attributes {
+		pendingValue := fmt.Sprintf("%v", value)
+		if pendingValue != bios.Attributes.String(attr) {
+			biosConfig[attr] = pendingValue
+		}
+	}
+
+	return biosConfig, nil
+}
+
+// SetBiosConfiguration updates the BIOS attributes to be applied at the given apply time,
+// the changes are applied on the next reset when no apply time is given.
+//
+// The biosConfig keys are vendor attribute names, vendor neutral keys (boot_mode, secure_boot, tpm, smt, sr_iov,
+// intel_sgx) or raw: prefixed vendor attribute names.
+func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime bmc.BiosApplyTime) (err error) {
+	sys, err := c.System()
+	if err != nil {
+		return err
 	}
 
 	if !c.compatibleOdataID(sys.ODataID, knownSystemsOdataIDs) {
@@ -55,11 +175,133 @@ func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string
 		return err
 	}
 
-	// TODO(jwb) We should handle passing different apply times here
-	return bios.UpdateBiosAttributesApplyAt(settingsAttributes, schemas.OnResetSettingsApplyTime)
+	biosConfig, err = c.vendorBiosConfiguration(bios, biosConfig)
+	if err != nil {
+		return err
+	}
+
+	settingsApplyTime, err := newBiosSettingsApplyTime(applyTime)
+	if err != nil {
+		return err
+	}
+
+	if len(biosConfig) == 0 {
+		return nil
+	}
+
+	// gofish drops the attributes matching the current value, which would keep a staged change the caller reverts
+	settingsURI, err := c.biosSettingsURI(bios.ODataID)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"Attributes":                 biosConfig,
+		"@Redfish.SettingsApplyTime": settingsApplyTime,
+	}
+
+	return c.patch(ctx, settingsURI, payload)
 }
 
-func (c *Client) ResetBiosConfiguration(ctx context.Context) (err error) {
+// StageBiosConfiguration updates the BIOS attributes in the settings resource without an apply time
+// and returns the settings resource URI, for BMCs that apply the staged changes through a vendor job.
+// No settings resource URI is returned when none of the attributes differ from the current values.
+func (c *Client) StageBiosConfiguration(ctx context.Context, biosConfig map[string]string) (settingsURI string, err error) {
+	sys, err := c.System()
+	if err != nil {
+		return "", err
+	}
+
+	bios, err := sys.Bios()
+	if err != nil {
+		return "", err
+	}
+
+	biosConfig, err = c.vendorBiosConfiguration(bios, biosConfig)
+	if err != nil {
+		return "", err
+	}
+
+	if len(biosConfig) == 0 {
+		return "", nil
+	}
+
+	settingsURI, err = c.biosSettingsURI(bios.ODataID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.patch(ctx, settingsURI, map[string]interface{}{"Attributes": biosConfig}); err != nil {
+		return "", err
+	}
+
+	return settingsURI, nil
+}
+
+// vendorBiosConfiguration translates the vendor neutral keys to the vendor attribute names and checks the attributes
+// against the attribute registry before they are sent, the attributes are left to the BMC to validate
+// when the registry cannot be retrieved.
+//
+// Attributes matching the current value are dropped, so a configuration exported with GetBiosConfiguration
+// can be applied as is, including its read only attributes. An attribute with a different value staged
+// in the settings resource is kept, so the staged change is reverted.
+func (c *Client) vendorBiosConfiguration(bios *schemas.Bios, biosConfig map[string]string) (map[string]string, error) {
+	registry, registryErr := c.biosAttributeRegistry(bios.AttributeRegistry)
+
+	vendorConfig, err := toVendorBiosConfiguration(biosConfig, bios.Attributes, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := c.pendingBiosAttributes(bios)
+	if err != nil {
+		return nil, err
+	}
+
+	for attr, value := range vendorConfig {
+		if _, staged := pending[attr]; staged {
+			continue
+		}
+
+		if _, exists := bios.Attributes[attr]; exists && bios.Attributes.String(attr) == value {
+			delete(vendorConfig, attr)
+		}
+	}
+
+	if registryErr != nil {
+		return vendorConfig, nil
+	}
+
+	if err := registry.Validate(vendorConfig); err != nil {
+		return nil, err
+	}
+
+	return vendorConfig, nil
+}
+
+// biosSettingsURI returns the Bios settings resource URI, or the Bios resource URI when the BMC updates it directly.
+func (c *Client) biosSettingsURI(biosURI string) (string, error) {
+	settings := &biosSettingsObject{}
+	if err := c.getResource(biosURI, settings); err != nil {
+		return "", err
+	}
+
+	if settings.Settings.SettingsObject.ODataID == "" {
+		return biosURI, nil
+	}
+
+	return settings.Settings.SettingsObject.ODataID, nil
+}
+
+// ResetBiosConfiguration resets the BIOS attributes to defaults at the given apply time,
+// the attributes are reset on the next reset when no apply time is given and the host is reset right away
+// with the Immediate apply time.
+func (c *Client) ResetBiosConfiguration(ctx context.Context, applyTime bmc.BiosApplyTime) (err error) {
+	settingsApplyTime, err := newBiosSettingsApplyTime(applyTime)
+	if err != nil {
+		return err
+	}
+
 	sys, err := c.System()
 	if err != nil {
 		return err
@@ -74,6 +316,72 @@ func (c *Client) ResetBiosConfiguration(ctx context.Context) (err error) {
 		return err
 	}
 
-	_, err = bios.ResetBios()
+	if applyTime.ApplyTime == constants.AtMaintenanceWindowStart {
+		return c.resetBiosAtMaintenanceWindow(ctx, bios.ODataID, settingsApplyTime)
+	}
+
+	if _, err = bios.ResetBios(); err != nil {
+		return err
+	}
+
+	if applyTime.ApplyTime == constants.Immediate {
+		_, err = c.PowerSet(ctx, "reset")
+	}
+
 	return err
 }
+
+// resetBiosAtMaintenanceWindow requests the ResetBios action with the maintenance window settings apply time annotation.
+func (c *Client) resetBiosAtMaintenanceWindow(ctx context.Context, biosURI string, settingsApplyTime biosSettingsApplyTime) error {
+	actions := &biosResetAction{}
+	if err := c.getResource(biosURI, actions); err != nil {
+		return err
+	}
+
+	if actions.Actions.ResetBios.Target == "" {
+		return errors.Wrap(bmclibErrs.ErrBiosApplyTime, "no ResetBios action listed for the Bios resource")
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"@Redfish.SettingsApplyTime": settingsApplyTime})
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PostWithHeaders(ctx, actions.Actions.ResetBios.Target, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// SetBiosConfigurationFromFile sets the BIOS attributes listed in the vendor neutral YAML or JSON profile,
+// the changes are applied on the next reset.
+func (c *Client) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
+	biosConfig, err := bmc.UnmarshalBiosProfile(cfg)
+	if err != nil {
+		return err
+	}
+
+	return c.SetBiosConfiguration(ctx, biosConfig, bmc.BiosApplyTime{})
+}
+
+// ExportBiosConfigurationToFile returns the BIOS configuration as a vendor neutral YAML or JSON profile,
+// the profile is accepted as is by SetBiosConfigurationFromFile.
+//
+// The profile lists the current values and not the changes staged in the settings resource,
+// setting the profile reverts the staged changes to the attributes it lists.
+func (c *Client) ExportBiosConfigurationToFile(ctx context.Context, format bmc.BiosConfigurationFormat) (cfg string, err error) {
+	switch format {
+	case bmc.BiosConfigurationYAML, bmc.BiosConfigurationJSON:
+	default:
+		return "", errors.Wrap(bmclibErrs.ErrBiosConfigurationFormat, string(format))
+	}
+
+	biosConfig, err := c.GetBiosConfiguration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return bmc.MarshalBiosProfile(biosConfig, format)
+}
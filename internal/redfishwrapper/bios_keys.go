@@ -0,0 +1,223 @@
+package redfishwrapper
+
+import (
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// rawBiosAttributePrefix prefixes a vendor BIOS attribute name, as with the sum provider.
+const rawBiosAttributePrefix = "raw:"
+
+// biosValueStyles lists the ways BMCs spell the normalized values,
+// each style lists the vendor values in the order of the normalized values.
+type biosValueStyles struct {
+	normalized []string
+	styles     [][]string
+	// unsupported are normalized values the sum provider accepts that have no Redfish equivalent.
+	unsupported []string
+}
+
+var (
+	enabledDisabledStyles = biosValueStyles{
+		normalized: []string{"Enabled", "Disabled"},
+		styles: [][]string{
+			{"Enabled", "Disabled"},
+			{"On", "Off"},
+			{"Enable", "Disable"},
+			{"true", "false"},
+		},
+	}
+
+	// the boot modes are normalized to the values of the bmc-toolbox/common VendorConfigManager,
+	// BIOS is accepted for LEGACY as it's returned by the common StandardConfig.
+	bootModeStyles = biosValueStyles{
+		normalized: []string{"UEFI", "LEGACY"},
+		styles: [][]string{
+			{"Uefi", "Bios"},
+			{"Uefi", "LegacyBios"},
+			{"Uefi", "Legacy"},
+			{"UEFI", "BIOS"},
+			{"UEFI", "LEGACY"},
+		},
+		unsupported: []string{"DUAL"},
+	}
+)
+
+// normalizedBiosAttribute maps a vendor neutral BIOS setting name to the vendor attribute names,
+// the Dell attribute name is listed first followed by names used by other Redfish implementations.
+type normalizedBiosAttribute struct {
+	key        string
+	attributes []string
+	values     biosValueStyles
+}
+
+// normalizedBiosAttributes is the vendor neutral BIOS setting key space shared with the sum provider,
+// boot_order is not listed as it has no Redfish attribute equivalent.
+//
+// Keys are only listed here when the sum provider applies them too, so one configuration applies to both.
+var normalizedBiosAttributes = []normalizedBiosAttribute{
+	{"boot_mode", []string{"BootMode", "BootModeSelect"}, bootModeStyles},
+	{"secure_boot", []string{"SecureBoot", "SecureBootEnable"}, enabledDisabledStyles},
+	{"tpm", []string{"TpmSecurity", "TpmState", "TPMState"}, enabledDisabledStyles},
+	{"smt", []string{"LogicalProc", "ProcHyperthreading", "Hyperthreading", "HyperThreading"}, enabledDisabledStyles},
+	{"sr_iov", []string{"SriovGlobalEnable", "SRIOVEnable", "SrIov"}, enabledDisabledStyles},
+	{"intel_sgx", []string{"IntelSgx", "SgxEnable"}, enabledDisabledStyles},
+}
+
+// vendorAttribute returns the first of the vendor attribute names the BMC lists.
+func (n normalizedBiosAttribute) vendorAttribute(attributes schemas.SettingsAttributes) (string, bool) {
+	for _, name := range n.attributes {
+		if _, exists := attributes[name]; exists {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizedValue returns the normalized value of the vendor value,
+// values not listed in any style are returned as is.
+func (s biosValueStyles) normalizedValue(value string) string {
+	for _, style := range s.styles {
+		for i, styleValue := range style {
+			if strings.EqualFold(value, styleValue) {
+				return s.normalized[i]
+			}
+		}
+	}
+
+	return value
+}
+
+// vendorValue returns the vendor value of the normalized value in the style of the BMC,
+// the style is picked from the allowed values in the attribute registry, or the current value of the attribute.
+// Values that are not normalized are returned as is.
+func (s biosValueStyles) vendorValue(value, current string, allowed []string) string {
+	value = s.normalizedValue(value)
+
+	idx := -1
+	for i, normalized := range s.normalized {
+		if strings.EqualFold(value, normalized) {
+			idx = i
+		}
+	}
+
+	if idx == -1 {
+		return value
+	}
+
+	if len(allowed) > 0 {
+		for _, style := range s.styles {
+			if containsAll(allowed, style) {
+				return style[idx]
+			}
+		}
+	}
+
+	for _, style := range s.styles {
+		for _, styleValue := range style {
+			if current == styleValue {
+				return style[idx]
+			}
+		}
+	}
+
+	return s.styles[0][idx]
+}
+
+func containsAll(values, wanted []string) bool {
+	for _, w := range wanted {
+		found := false
+		for _, v := range values {
+			if v == w {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withNormalizedBiosAttributes adds the vendor neutral keys for the attributes the BMC lists to the BIOS configuration.
+func withNormalizedBiosAttributes(biosConfig map[string]string, attributes schemas.SettingsAttributes) map[string]string {
+	for _, normalized := range normalizedBiosAttributes {
+		name, exists := normalized.vendorAttribute(attributes)
+		if !exists {
+			continue
+		}
+
+		biosConfig[normalized.key] = normalized.values.normalizedValue(attributes.String(name))
+	}
+
+	return biosConfig
+}
+
+// toVendorBiosConfiguration translates the vendor neutral and raw: prefixed keys to the vendor attribute names,
+// other keys are taken to be vendor attribute names. A vendor neutral key listed along with its vendor attribute,
+// as with the configuration returned by GetBiosConfiguration, must hold the same value, or an error is returned
+// as it is not known which of the two was meant to be changed.
+func toVendorBiosConfiguration(biosConfig map[string]string, attributes schemas.SettingsAttributes, registry bmc.BiosAttributeRegistry) (map[string]string, error) {
+	vendorConfig := make(map[string]string, len(biosConfig))
+
+	for key, value := range biosConfig {
+		switch {
+		case key == "boot_order":
+			return nil, errors.Wrap(bmclibErrs.ErrBiosAttributeInvalid, key+": not supported through redfish")
+		case strings.HasPrefix(key, rawBiosAttributePrefix):
+			vendorConfig[strings.TrimPrefix(key, rawBiosAttributePrefix)] = value
+		case !isNormalizedBiosKey(key):
+			vendorConfig[key] = value
+		}
+	}
+
+	for _, normalized := range normalizedBiosAttributes {
+		value, exists := biosConfig[normalized.key]
+		if !exists {
+			continue
+		}
+
+		for _, unsupported := range normalized.values.unsupported {
+			if strings.EqualFold(value, unsupported) {
+				return nil, errors.Wrap(bmclibErrs.ErrBiosAttributeInvalid, normalized.key+": "+value+" is not supported through redfish")
+			}
+		}
+
+		name, exists := normalized.vendorAttribute(attributes)
+		if !exists {
+			return nil, errors.Wrap(bmclibErrs.ErrBiosAttributeInvalid, normalized.key+": no matching BIOS attribute on this BMC")
+		}
+
+		vendorValue := normalized.values.vendorValue(value, attributes.String(name), registry.Attributes[name].Values)
+
+		if listed, exists := vendorConfig[name]; exists &&
+			normalized.values.normalizedValue(listed) != normalized.values.normalizedValue(vendorValue) {
+			return nil, errors.Wrap(
+				bmclibErrs.ErrBiosAttributeInvalid,
+				normalized.key+": value "+value+" differs from the "+name+" value "+listed,
+			)
+		}
+
+		vendorConfig[name] = vendorValue
+	}
+
+	return vendorConfig, nil
+}
+
+func isNormalizedBiosKey(key string) bool {
+	for _, normalized := range normalizedBiosAttributes {
+		if normalized.key == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,213 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToVendorBiosConfiguration(t *testing.T) {
+	dellAttributes := schemas.SettingsAttributes{
+		"BootMode":          "Bios",
+		"LogicalProc":       "Enabled",
+		"SriovGlobalEnable": "Disabled",
+		"TpmSecurity":       "Off",
+		"NumLock":           "On",
+	}
+
+	// attributes in the style of other Redfish implementations
+	genericAttributes := schemas.SettingsAttributes{
+		"BootMode":           "LegacyBios",
+		"ProcHyperthreading": "Enabled",
+		"SecureBootEnable":   true,
+	}
+
+	testCases := []struct {
+		name       string
+		attributes schemas.SettingsAttributes
+		registry   bmc.BiosAttributeRegistry
+		biosConfig map[string]string
+		expected   map[string]string
+		err        error
+	}{
+		{
+			"dell",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"boot_mode": "UEFI", "smt": "Disabled", "sr_iov": "Enabled", "tpm": "Enabled", "raw:NumLock": "Off"},
+			map[string]string{"BootMode": "Uefi", "LogicalProc": "Disabled", "SriovGlobalEnable": "Enabled", "TpmSecurity": "On", "NumLock": "Off"},
+			nil,
+		},
+		{
+			"generic",
+			genericAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"boot_mode": "UEFI", "smt": "Disabled", "secure_boot": "Enabled"},
+			map[string]string{"BootMode": "Uefi", "ProcHyperthreading": "Disabled", "SecureBootEnable": "true"},
+			nil,
+		},
+		{
+			"style from registry",
+			schemas.SettingsAttributes{"BootMode": "Uefi"},
+			bmc.BiosAttributeRegistry{
+				Attributes: map[string]bmc.BiosAttribute{
+					"BootMode": {Name: "BootMode", Values: []string{"Uefi", "LegacyBios"}},
+				},
+			},
+			map[string]string{"boot_mode": "BIOS"},
+			map[string]string{"BootMode": "LegacyBios"},
+			nil,
+		},
+		{
+			"legacy boot mode",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"boot_mode": "LEGACY"},
+			map[string]string{"BootMode": "Bios"},
+			nil,
+		},
+		{
+			"dual boot mode",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"boot_mode": "DUAL"},
+			nil,
+			bmclibErrs.ErrBiosAttributeInvalid,
+		},
+		{
+			"vendor attributes and normalized keys",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"BootMode": "Uefi", "boot_mode": "UEFI", "ProcVirtualization": "Enabled"},
+			map[string]string{"BootMode": "Uefi", "ProcVirtualization": "Enabled"},
+			nil,
+		},
+		{
+			"vendor attribute differs from normalized key",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"BootMode": "Uefi", "boot_mode": "BIOS"},
+			nil,
+			bmclibErrs.ErrBiosAttributeInvalid,
+		},
+		{
+			"raw attribute differs from normalized key",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"raw:LogicalProc": "Disabled", "smt": "Enabled"},
+			nil,
+			bmclibErrs.ErrBiosAttributeInvalid,
+		},
+		{
+			"attribute not listed",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"intel_sgx": "Enabled"},
+			nil,
+			bmclibErrs.ErrBiosAttributeInvalid,
+		},
+		{
+			"boot order",
+			dellAttributes,
+			bmc.BiosAttributeRegistry{},
+			map[string]string{"boot_order": "UEFI"},
+			nil,
+			bmclibErrs.ErrBiosAttributeInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vendorConfig, err := toVendorBiosConfiguration(tc.biosConfig, tc.attributes, tc.registry)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, vendorConfig)
+		})
+	}
+}
+
+func TestWithNormalizedBiosAttributes(t *testing.T) {
+	attributes := schemas.SettingsAttributes{
+		"BootMode":           "LegacyBios",
+		"ProcHyperthreading": "Enabled",
+		"SecureBootEnable":   true,
+		"TpmState":           "Off",
+	}
+
+	expected := map[string]string{
+		"boot_mode":   "LEGACY",
+		"smt":         "Enabled",
+		"secure_boot": "Enabled",
+		"tpm":         "Disabled",
+	}
+
+	assert.Equal(t, expected, withNormalizedBiosAttributes(map[string]string{}, attributes))
+}
+
+func TestSetBiosConfigurationNormalized(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	client, closeFn := biosRegistryClient(t, "/dell/bios_settings.json", patched)
+	defer closeFn()
+
+	err := client.SetBiosConfiguration(context.Background(), map[string]string{"boot_mode": "UEFI"}, bmc.BiosApplyTime{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"BootMode": "Uefi"}, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"])
+}
+
+// TestToVendorBiosConfigurationSharedKeys applies the desired state the sum provider tests apply too.
+func TestToVendorBiosConfigurationSharedKeys(t *testing.T) {
+	b, err := os.ReadFile("../../fixtures/internal/bios/desired_state.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	biosConfig := map[string]string{}
+	if err := json.Unmarshal(b, &biosConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	attributes := schemas.SettingsAttributes{
+		"BootMode":          "Uefi",
+		"SecureBoot":        "Enabled",
+		"TpmSecurity":       "Off",
+		"LogicalProc":       "Disabled",
+		"SriovGlobalEnable": "Disabled",
+		"IntelSgx":          "On",
+	}
+
+	expected := map[string]string{
+		"BootMode":          "Bios",
+		"SecureBoot":        "Disabled",
+		"TpmSecurity":       "On",
+		"LogicalProc":       "Enabled",
+		"SriovGlobalEnable": "Enabled",
+		"IntelSgx":          "Off",
+	}
+
+	vendorConfig, err := toVendorBiosConfiguration(biosConfig, attributes, bmc.BiosAttributeRegistry{})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, vendorConfig)
+
+	// the normalized configuration read back applies on the sum provider as is
+	assert.Equal(t, biosConfig, withNormalizedBiosAttributes(map[string]string{}, toSettingsAttributes(vendorConfig)))
+}
+
+func toSettingsAttributes(config map[string]string) schemas.SettingsAttributes {
+	attributes := schemas.SettingsAttributes{}
+	for k, v := range config {
+		attributes[k] = v
+	}
+
+	return attributes
+}
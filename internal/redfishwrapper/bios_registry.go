@@ -0,0 +1,104 @@
+package redfishwrapper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// BiosAttributeRegistry returns the attribute registry referenced by the system Bios resource.
+func (c *Client) BiosAttributeRegistry(ctx context.Context) (registry bmc.BiosAttributeRegistry, err error) {
+	sys, err := c.System()
+	if err != nil {
+		return registry, err
+	}
+
+	bios, err := sys.Bios()
+	if err != nil {
+		return registry, err
+	}
+
+	if bios == nil {
+		return registry, bmclibErrs.ErrNoBiosAttributes
+	}
+
+	return c.biosAttributeRegistry(bios.AttributeRegistry)
+}
+
+// biosAttributeRegistry looks up the attribute registry in the Registries collection,
+// the registry is matched on the registry file Id or Registry, Dell lists the registry file
+// without the version suffix of the Bios AttributeRegistry value.
+func (c *Client) biosAttributeRegistry(name string) (registry bmc.BiosAttributeRegistry, err error) {
+	if name == "" {
+		return registry, errors.Wrap(bmclibErrs.ErrBiosAttributeRegistryNotFound, "no attribute registry referenced by the Bios resource")
+	}
+
+	files, err := c.client.Service.Registries()
+	if err != nil {
+		return registry, err
+	}
+
+	for _, file := range files {
+		if file.ID != name && file.Registry != name && !strings.HasPrefix(name, file.ID+".") {
+			continue
+		}
+
+		for _, location := range file.Location {
+			if location.URI == "" {
+				continue
+			}
+
+			attributeRegistry, err := schemas.GetAttributeRegistry(c.client, location.URI)
+			if err != nil {
+				return registry, err
+			}
+
+			return toBiosAttributeRegistry(attributeRegistry), nil
+		}
+	}
+
+	return registry, errors.Wrap(bmclibErrs.ErrBiosAttributeRegistryNotFound, name)
+}
+
+func toBiosAttributeRegistry(attributeRegistry *schemas.AttributeRegistry) bmc.BiosAttributeRegistry {
+	registry := bmc.BiosAttributeRegistry{
+		ID:         attributeRegistry.ID,
+		Version:    attributeRegistry.RegistryVersion,
+		Attributes: make(map[string]bmc.BiosAttribute, len(attributeRegistry.RegistryEntries.Attributes)),
+	}
+
+	for _, entry := range attributeRegistry.RegistryEntries.Attributes {
+		attribute := bmc.BiosAttribute{
+			Name:            entry.AttributeName,
+			DisplayName:     entry.DisplayName,
+			Type:            bmc.BiosAttributeType(entry.Type),
+			ReadOnly:        entry.ReadOnly,
+			ResetRequired:   entry.ResetRequired,
+			MinLength:       entry.MinLength,
+			MaxLength:       entry.MaxLength,
+			ValueExpression: entry.ValueExpression,
+		}
+
+		if entry.LowerBound != nil {
+			lowerBound := int64(*entry.LowerBound)
+			attribute.LowerBound = &lowerBound
+		}
+
+		if entry.UpperBound != nil {
+			upperBound := int64(*entry.UpperBound)
+			attribute.UpperBound = &upperBound
+		}
+
+		for _, value := range entry.Value {
+			attribute.Values = append(attribute.Values, value.ValueName)
+		}
+
+		registry.Attributes[entry.AttributeName] = attribute
+	}
+
+	return registry
+}
@@ -0,0 +1,71 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// biosRegistryClient returns a client for the Dell BIOS with the attribute registry, the settings fixture holds the staged changes.
+func biosRegistryClient(t *testing.T, settingsFixture string, patched map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                            endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                                     endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":                   endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios":              endpointFunc(t, "/dell/bios.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Settings":     patchRecorder(t, settingsFixture, patched),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/BiosRegistry": endpointFunc(t, "/dell/bios_registry.json"),
+		"/redfish/v1/Registries":                                  endpointFunc(t, "/dell/registries.json"),
+		"/redfish/v1/Registries/BaseMessages":                     endpointFunc(t, "/dell/registries_basemessages.json"),
+		"/redfish/v1/Registries/BiosAttributeRegistry":            endpointFunc(t, "/dell/registries_biosattributeregistry.json"),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestBiosAttributeRegistry(t *testing.T) {
+	client, closeFn := biosRegistryClient(t, "/dell/bios_settings.json", map[string]map[string]interface{}{})
+	defer closeFn()
+
+	registry, err := client.BiosAttributeRegistry(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, "BiosAttributeRegistry.v1_0_3", registry.ID)
+	assert.Equal(t, "v1_0_3", registry.Version)
+	assert.Len(t, registry.Attributes, 4)
+
+	lowerBound, upperBound := int64(60), int64(600)
+	assert.Equal(t, bmc.BiosAttribute{
+		Name:          "AcPwrRcvryUserDelay",
+		DisplayName:   "User Defined Delay (60s to 600s)",
+		Type:          bmc.BiosAttributeInteger,
+		ResetRequired: true,
+		LowerBound:    &lowerBound,
+		UpperBound:    &upperBound,
+	}, registry.Attributes["AcPwrRcvryUserDelay"])
+
+	assert.Equal(t, []string{"Bios", "Uefi"}, registry.Attributes["BootMode"].Values)
+	assert.True(t, registry.Attributes["SystemServiceTag"].ReadOnly)
+}
+
+func TestSetBiosConfigurationValidation(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	client, closeFn := biosRegistryClient(t, "/dell/bios_settings.json", patched)
+	defer closeFn()
+
+	err := client.SetBiosConfiguration(context.Background(), map[string]string{"BootMode": "Legacy"}, bmc.BiosApplyTime{})
+	assert.ErrorIs(t, err, bmclibErrs.ErrBiosAttributeInvalid)
+	assert.ErrorContains(t, err, `BootMode: value "Legacy" is not one of Bios, Uefi`)
+	assert.Empty(t, patched)
+
+	err = client.SetBiosConfiguration(context.Background(), map[string]string{"BootMode": "Uefi"}, bmc.BiosApplyTime{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"BootMode": "Uefi"}, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"])
+}
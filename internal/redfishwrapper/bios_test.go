@@ -10,7 +10,11 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +45,13 @@ func biosConfigFromFixture(t *testing.T) map[string]string {
 		expectedBiosConfig[k] = fmt.Sprintf("%v", v)
 	}
 
+	// the vendor neutral keys are returned alongside the Dell attributes
+	expectedBiosConfig["boot_mode"] = "LEGACY"
+	expectedBiosConfig["secure_boot"] = "Disabled"
+	expectedBiosConfig["smt"] = "Enabled"
+	expectedBiosConfig["sr_iov"] = "Disabled"
+	expectedBiosConfig["tpm"] = "Enabled"
+
 	return expectedBiosConfig
 }
 
@@ -92,3 +103,140 @@ func TestGetBiosConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPendingBiosConfiguration(t *testing.T) {
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                        endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                                 endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":               endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios":          endpointFunc(t, "/dell/bios.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Settings": endpointFunc(t, "/dell/bios_settings.json"),
+	}
+
+	client, closeFn := newTestClient(t, handlers)
+	defer closeFn()
+
+	ctx := context.Background()
+
+	// LogicalProc is listed in the settings resource with its current value and is not pending
+	expected := map[string]string{
+		"BootMode":          "Uefi",
+		"SriovGlobalEnable": "Enabled",
+		"boot_mode":         "UEFI",
+		"sr_iov":            "Enabled",
+	}
+
+	pending, err := client.GetPendingBiosConfiguration(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, pending)
+}
+
+func TestSetBiosConfigurationMaintenanceWindow(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	posted := map[string]map[string]interface{}{}
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                                      endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                                               endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":                             endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios":                        endpointFunc(t, "/dell/bios.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Settings":               patchRecorder(t, "/dell/bios_settings.json", patched),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Actions/Bios.ResetBios": postRecorder(t, "/dell/bios.json", posted),
+	}
+
+	client, closeFn := newTestClient(t, handlers)
+	defer closeFn()
+
+	ctx := context.Background()
+
+	applyTime := bmc.BiosApplyTime{
+		ApplyTime:                 constants.AtMaintenanceWindowStart,
+		MaintenanceWindowStart:    time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC),
+		MaintenanceWindowDuration: time.Hour,
+	}
+
+	err := client.SetBiosConfiguration(ctx, map[string]string{"BootMode": "Uefi"}, applyTime)
+	assert.Nil(t, err)
+
+	expected := map[string]interface{}{
+		"Attributes": map[string]interface{}{"BootMode": "Uefi"},
+		"@Redfish.SettingsApplyTime": map[string]interface{}{
+			"ApplyTime":                          "AtMaintenanceWindowStart",
+			"MaintenanceWindowStartTime":         "2026-01-02T03:00:00Z",
+			"MaintenanceWindowDurationInSeconds": float64(3600),
+		},
+	}
+
+	assert.Equal(t, expected, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"])
+
+	err = client.ResetBiosConfiguration(ctx, applyTime)
+	assert.Nil(t, err)
+
+	expectedReset := map[string]interface{}{
+		"@Redfish.SettingsApplyTime": map[string]interface{}{
+			"ApplyTime":                          "AtMaintenanceWindowStart",
+			"MaintenanceWindowStartTime":         "2026-01-02T03:00:00Z",
+			"MaintenanceWindowDurationInSeconds": float64(3600),
+		},
+	}
+
+	assert.Equal(t, expectedReset, posted["/redfish/v1/Systems/System.Embedded.1/Bios/Actions/Bios.ResetBios"])
+
+	err = client.ResetBiosConfiguration(ctx, bmc.BiosApplyTime{ApplyTime: "Never"})
+	assert.ErrorIs(t, err, bmclibErrs.ErrBiosApplyTime)
+}
+
+func TestSetBiosConfigurationRevertsPending(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	client, closeFn := biosRegistryClient(t, "/dell/bios_settings.json", patched)
+	defer closeFn()
+
+	// BootMode is Bios with Uefi staged in the settings resource, setting the current value reverts the staged change
+	err := client.SetBiosConfiguration(context.Background(), map[string]string{"BootMode": "Bios"}, bmc.BiosApplyTime{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"BootMode": "Bios"}, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"])
+}
+
+func TestBiosConfigurationFileRoundTrip(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	// the exported configuration holds the current values, none of which differ from staged values
+	client, closeFn := biosRegistryClient(t, "/dell/bios_settings_applied.json", patched)
+	defer closeFn()
+
+	for _, format := range []bmc.BiosConfigurationFormat{bmc.BiosConfigurationYAML, bmc.BiosConfigurationJSON} {
+		cfg, err := client.ExportBiosConfigurationToFile(context.Background(), format)
+		assert.Nil(t, err)
+
+		// the exported configuration is accepted as is, without changes to apply
+		assert.Nil(t, client.SetBiosConfigurationFromFile(context.Background(), cfg))
+		assert.Empty(t, patched)
+	}
+
+	cfg, err := client.ExportBiosConfigurationToFile(context.Background(), bmc.BiosConfigurationYAML)
+	assert.Nil(t, err)
+
+	biosConfig, err := bmc.UnmarshalBiosProfile(cfg)
+	assert.Nil(t, err)
+
+	// the exported profile lists both boot_mode and BootMode, editing only one of them is ambiguous
+	biosConfig["BootMode"] = "Uefi"
+
+	cfg, err = bmc.MarshalBiosProfile(biosConfig, bmc.BiosConfigurationYAML)
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, client.SetBiosConfigurationFromFile(context.Background(), cfg), bmclibErrs.ErrBiosAttributeInvalid)
+	assert.Empty(t, patched)
+
+	biosConfig["boot_mode"] = "UEFI"
+
+	cfg, err = bmc.MarshalBiosProfile(biosConfig, bmc.BiosConfigurationYAML)
+	assert.Nil(t, err)
+
+	assert.Nil(t, client.SetBiosConfigurationFromFile(context.Background(), cfg))
+	assert.Equal(t, map[string]interface{}{"BootMode": "Uefi"}, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"])
+
+	_, err = client.ExportBiosConfigurationToFile(context.Background(), bmc.BiosConfigurationSCPXML)
+	assert.ErrorIs(t, err, bmclibErrs.ErrBiosConfigurationFormat)
+}
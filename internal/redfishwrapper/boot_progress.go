@@ -0,0 +1,64 @@
+package redfishwrapper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+var bootProgressStages = map[schemas.BootProgressTypes]bmc.BootProgressStage{
+	schemas.NoneBootProgressTypes:                                    bmc.BootProgressStageNone,
+	schemas.PrimaryProcessorInitializationStartedBootProgressTypes:   bmc.BootProgressStagePOST,
+	schemas.BusInitializationStartedBootProgressTypes:                bmc.BootProgressStagePOST,
+	schemas.MemoryInitializationStartedBootProgressTypes:             bmc.BootProgressStagePOST,
+	schemas.SecondaryProcessorInitializationStartedBootProgressTypes: bmc.BootProgressStagePOST,
+	schemas.PCIResourceConfigStartedBootProgressTypes:                bmc.BootProgressStagePOST,
+	schemas.SystemHardwareInitializationCompleteBootProgressTypes:    bmc.BootProgressStagePOSTComplete,
+	schemas.SetupEnteredBootProgressTypes:                            bmc.BootProgressStageSetup,
+	schemas.OSBootStartedBootProgressTypes:                           bmc.BootProgressStageOSBootStarted,
+	schemas.OSRunningBootProgressTypes:                               bmc.BootProgressStageOSRunning,
+	schemas.OEMBootProgressTypes:                                     bmc.BootProgressStageOEM,
+}
+
+// BootProgress returns the redfish BootProgress normalized to the bmclib boot progress stages.
+func BootProgress(bp *schemas.BootProgress) bmc.BootProgress {
+	progress := bmc.BootProgress{
+		Stage: bmc.BootProgressStageUnknown,
+		State: string(bp.LastState),
+	}
+
+	if stage, exists := bootProgressStages[bp.LastState]; exists {
+		progress.Stage = stage
+	}
+
+	if bp.LastState == schemas.OEMBootProgressTypes && bp.OEMLastState != "" {
+		progress.State = bp.OEMLastState
+	}
+
+	if ts, err := time.Parse(time.RFC3339, bp.LastStateTime); err == nil {
+		progress.Timestamp = ts
+	} else {
+		progress.Timestamp = time.Now()
+	}
+
+	return progress
+}
+
+// SystemBootProgress returns the normalized boot progress of the system.
+func (c *Client) SystemBootProgress(_ context.Context) (bmc.BootProgress, error) {
+	system, err := c.System()
+	if err != nil {
+		return bmc.BootProgress{}, fmt.Errorf("retrieving redfish system: %w", err)
+	}
+
+	// see GetBootProgress for why this is gated on the redfish version.
+	if !redfishVersionMeetsOrExceeds(c.client.Service.RedfishVersion, 1, 13, 0) {
+		return bmc.BootProgress{}, fmt.Errorf("%w: %s", bmclibErrs.ErrRedfishVersionIncompatible, c.client.Service.RedfishVersion)
+	}
+
+	return BootProgress(&system.BootProgress), nil
+}
@@ -0,0 +1,127 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootProgress(t *testing.T) {
+	testcases := []struct {
+		name        string
+		bp          *schemas.BootProgress
+		expectStage bmc.BootProgressStage
+		expectState string
+		expectTime  time.Time
+	}{
+		{
+			"memory init",
+			&schemas.BootProgress{LastState: schemas.MemoryInitializationStartedBootProgressTypes},
+			bmc.BootProgressStagePOST,
+			"MemoryInitializationStarted",
+			time.Time{},
+		},
+		{
+			"os running with timestamp",
+			&schemas.BootProgress{LastState: schemas.OSRunningBootProgressTypes, LastStateTime: "2024-03-01T10:20:30+00:00"},
+			bmc.BootProgressStageOSRunning,
+			"OSRunning",
+			time.Date(2024, 3, 1, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			"oem state",
+			&schemas.BootProgress{LastState: schemas.OEMBootProgressTypes, OEMLastState: "PXEBootStarted"},
+			bmc.BootProgressStageOEM,
+			"PXEBootStarted",
+			time.Time{},
+		},
+		{
+			"unknown state",
+			&schemas.BootProgress{LastState: "Foo"},
+			bmc.BootProgressStageUnknown,
+			"Foo",
+			time.Time{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := BootProgress(tc.bp)
+			assert.Equal(t, tc.expectStage, got.Stage)
+			assert.Equal(t, tc.expectState, got.State)
+			assert.False(t, got.Timestamp.IsZero())
+
+			if !tc.expectTime.IsZero() {
+				assert.True(t, tc.expectTime.Equal(got.Timestamp))
+			}
+		})
+	}
+}
+
+func TestSystemBootProgress(t *testing.T) {
+	tests := map[string]struct {
+		hfunc  map[string]func(http.ResponseWriter, *http.Request)
+		expect bmc.BootProgressStage
+		err    error
+	}{
+		"happy case": {
+			hfunc: map[string]func(http.ResponseWriter, *http.Request){
+				"/redfish/v1/":          endpointFunc(t, "smc_1.14.0_serviceroot.json"),
+				"/redfish/v1/Systems":   endpointFunc(t, "smc_1.14.0_systems.json"),
+				"/redfish/v1/Systems/1": endpointFunc(t, "smc_1.14.0_systems_1.json"),
+			},
+			expect: bmc.BootProgressStagePOSTComplete,
+		},
+		"insufficient redfish version": {
+			hfunc: map[string]func(http.ResponseWriter, *http.Request){
+				"/redfish/v1/":          endpointFunc(t, "smc_1.9.0_serviceroot.json"),
+				"/redfish/v1/Systems":   endpointFunc(t, "smc_1.14.0_systems.json"),
+				"/redfish/v1/Systems/1": endpointFunc(t, "smc_1.14.0_systems_1.json"),
+			},
+			err: bmclibErrs.ErrRedfishVersionIncompatible,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			for endpoint, handler := range tc.hfunc {
+				mux.HandleFunc(endpoint, handler)
+			}
+
+			server := httptest.NewTLSServer(mux)
+			defer server.Close()
+
+			parsedURL, err := url.Parse(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+
+			err = client.Open(context.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close(context.TODO())
+
+			got, err := client.SystemBootProgress(context.TODO())
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, got.Stage)
+			assert.Equal(t, "SystemHardwareInitializationComplete", got.State)
+		})
+	}
+}
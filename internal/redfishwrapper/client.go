@@ -3,6 +3,7 @@ package redfishwrapper
 import (
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -299,6 +300,41 @@ func (c *Client) PatchWithHeaders(ctx context.Context, url string, payload inter
 	return c.client.PatchWithHeaders(url, payload, headers)
 }
 
+// patch sends the payload as a PATCH request to the redfish resource.
+func (c *Client) patch(ctx context.Context, uri string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PatchWithHeaders(ctx, uri, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// getResource reads the redfish resource JSON into v.
+func (c *Client) getResource(uri string, v interface{}) error {
+	resp, err := c.Get(uri)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("unexpected status reading " + uri + ": " + resp.Status)
+	}
+
+	return json.Unmarshal(body, v)
+}
+
 func (c *Client) Tasks(ctx context.Context) ([]*schemas.Task, error) {
 	ts, err := c.client.Service.Tasks()
 	if err != nil {
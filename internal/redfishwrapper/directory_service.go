@@ -0,0 +1,153 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// externalAccountProviderPayload is the AccountService LDAP and ActiveDirectory update payload.
+type externalAccountProviderPayload struct {
+	ServiceEnabled    bool                   `json:"ServiceEnabled"`
+	ServiceAddresses  []string               `json:"ServiceAddresses,omitempty"`
+	Authentication    *authenticationPayload `json:"Authentication,omitempty"`
+	LDAPService       *ldapServicePayload    `json:"LDAPService,omitempty"`
+	RemoteRoleMapping *[]roleMappingPayload  `json:"RemoteRoleMapping,omitempty"`
+}
+
+type authenticationPayload struct {
+	AuthenticationType string `json:"AuthenticationType"`
+	Username           string `json:"Username,omitempty"`
+	Password           string `json:"Password,omitempty"`
+}
+
+type ldapServicePayload struct {
+	SearchSettings ldapSearchSettingsPayload `json:"SearchSettings"`
+}
+
+type ldapSearchSettingsPayload struct {
+	BaseDistinguishedNames []string `json:"BaseDistinguishedNames,omitempty"`
+	UsernameAttribute      string   `json:"UsernameAttribute,omitempty"`
+	GroupsAttribute        string   `json:"GroupsAttribute,omitempty"`
+}
+
+type roleMappingPayload struct {
+	RemoteGroup string `json:"RemoteGroup"`
+	LocalRole   string `json:"LocalRole"`
+}
+
+// accountServiceProvider returns the AccountService LDAP or ActiveDirectory external account provider,
+// ErrNotImplemented is returned when the AccountService does not include the provider.
+func (c *Client) accountServiceProvider(serviceType bmc.DirectoryServiceType) (*schemas.AccountService, *schemas.ExternalAccountProvider, error) {
+	if serviceType != bmc.DirectoryServiceLDAP && serviceType != bmc.DirectoryServiceActiveDirectory {
+		return nil, nil, errors.Wrap(bmclibErrs.ErrDirectoryService, "unsupported directory service type: "+string(serviceType))
+	}
+
+	accountService, err := c.AccountService()
+	if err != nil {
+		return nil, nil, errors.Wrap(bmclibErrs.ErrDirectoryService, err.Error())
+	}
+
+	// gofish returns a zero value provider when the property is not present,
+	// the raw data is checked to identify BMCs that do not implement the provider.
+	properties := map[string]json.RawMessage{}
+	if err := json.Unmarshal(accountService.RawData, &properties); err != nil {
+		return nil, nil, errors.Wrap(bmclibErrs.ErrDirectoryService, err.Error())
+	}
+
+	if _, exists := properties[string(serviceType)]; !exists {
+		return nil, nil, errors.Wrap(bmclibErrs.ErrNotImplemented, "AccountService does not include "+string(serviceType))
+	}
+
+	if serviceType == bmc.DirectoryServiceActiveDirectory {
+		return accountService, &accountService.ActiveDirectory, nil
+	}
+
+	return accountService, &accountService.LDAP, nil
+}
+
+// GetDirectoryService returns the AccountService LDAP or ActiveDirectory configuration.
+func (c *Client) GetDirectoryService(_ context.Context, serviceType bmc.DirectoryServiceType) (bmc.DirectoryService, error) {
+	_, provider, err := c.accountServiceProvider(serviceType)
+	if err != nil {
+		return bmc.DirectoryService{}, err
+	}
+
+	service := bmc.DirectoryService{
+		Type:                   serviceType,
+		Enabled:                provider.ServiceEnabled,
+		BaseDistinguishedNames: provider.LDAPService.SearchSettings.BaseDistinguishedNames,
+		UsernameAttribute:      provider.LDAPService.SearchSettings.UsernameAttribute,
+		GroupsAttribute:        provider.LDAPService.SearchSettings.GroupsAttribute,
+		BindUsername:           provider.Authentication.Username,
+	}
+
+	// BMCs return empty strings for the unset address slots
+	for _, address := range provider.ServiceAddresses {
+		if address != "" {
+			service.ServiceAddresses = append(service.ServiceAddresses, address)
+		}
+	}
+
+	for _, mapping := range provider.RemoteRoleMapping {
+		service.RoleMappings = append(service.RoleMappings, bmc.DirectoryRoleMapping{
+			RemoteGroup: mapping.RemoteGroup,
+			LocalRole:   mapping.LocalRole,
+		})
+	}
+
+	return service, nil
+}
+
+// SetDirectoryService updates the AccountService LDAP or ActiveDirectory configuration.
+func (c *Client) SetDirectoryService(ctx context.Context, service bmc.DirectoryService) error {
+	accountService, _, err := c.accountServiceProvider(service.Type)
+	if err != nil {
+		return err
+	}
+
+	provider := externalAccountProviderPayload{
+		ServiceEnabled:   service.Enabled,
+		ServiceAddresses: service.ServiceAddresses,
+	}
+
+	if service.BindUsername != "" || service.BindPassword != "" {
+		provider.Authentication = &authenticationPayload{
+			AuthenticationType: string(schemas.UsernameAndPasswordAuthenticationTypes),
+			Username:           service.BindUsername,
+			Password:           service.BindPassword,
+		}
+	}
+
+	if len(service.BaseDistinguishedNames) > 0 || service.UsernameAttribute != "" || service.GroupsAttribute != "" {
+		provider.LDAPService = &ldapServicePayload{
+			SearchSettings: ldapSearchSettingsPayload{
+				BaseDistinguishedNames: service.BaseDistinguishedNames,
+				UsernameAttribute:      service.UsernameAttribute,
+				GroupsAttribute:        service.GroupsAttribute,
+			},
+		}
+	}
+
+	// the mappings are left unchanged when nil, an empty list clears the existing mappings.
+	if service.RoleMappings != nil {
+		mappings := make([]roleMappingPayload, 0, len(service.RoleMappings))
+		for _, mapping := range service.RoleMappings {
+			mappings = append(mappings, roleMappingPayload{RemoteGroup: mapping.RemoteGroup, LocalRole: mapping.LocalRole})
+		}
+
+		provider.RemoteRoleMapping = &mappings
+	}
+
+	payload := map[string]interface{}{string(service.Type): provider}
+
+	if err := c.patch(ctx, accountService.ODataID, payload); err != nil {
+		return errors.Wrap(bmclibErrs.ErrDirectoryService, err.Error())
+	}
+
+	return nil
+}
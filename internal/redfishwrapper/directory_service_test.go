@@ -0,0 +1,148 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func directoryServiceClient(t *testing.T, fixture string, patched map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":               endpointFunc(t, "serviceroot.json"),
+		"/redfish/v1/AccountService": patchRecorder(t, fixture, patched),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestGetDirectoryService(t *testing.T) {
+	tests := map[string]struct {
+		fixture     string
+		serviceType bmc.DirectoryServiceType
+		expect      bmc.DirectoryService
+		err         error
+	}{
+		"ldap": {
+			fixture:     "accounts/accountservice.json",
+			serviceType: bmc.DirectoryServiceLDAP,
+			expect: bmc.DirectoryService{
+				Type:                   bmc.DirectoryServiceLDAP,
+				Enabled:                true,
+				ServiceAddresses:       []string{"ldaps://ldap.example.com"},
+				BaseDistinguishedNames: []string{"dc=example,dc=com"},
+				UsernameAttribute:      "uid",
+				GroupsAttribute:        "memberOf",
+				BindUsername:           "cn=bmc,dc=example,dc=com",
+				RoleMappings: []bmc.DirectoryRoleMapping{
+					{RemoteGroup: "cn=bmc-admins,dc=example,dc=com", LocalRole: "Administrator"},
+				},
+			},
+		},
+		"active directory": {
+			fixture:     "accounts/accountservice.json",
+			serviceType: bmc.DirectoryServiceActiveDirectory,
+			expect:      bmc.DirectoryService{Type: bmc.DirectoryServiceActiveDirectory},
+		},
+		"ldap not implemented": {
+			fixture:     "accounts/accountservice_no_ldap.json",
+			serviceType: bmc.DirectoryServiceLDAP,
+			err:         bmclibErrs.ErrNotImplemented,
+		},
+		"unsupported type": {
+			fixture:     "accounts/accountservice.json",
+			serviceType: "TACACS",
+			err:         bmclibErrs.ErrDirectoryService,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, closeFn := directoryServiceClient(t, tc.fixture, map[string]map[string]interface{}{})
+			defer closeFn()
+
+			got, err := client.GetDirectoryService(context.TODO(), tc.serviceType)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestSetDirectoryService(t *testing.T) {
+	tests := map[string]struct {
+		service bmc.DirectoryService
+		expect  map[string]interface{}
+	}{
+		"ldap": {
+			service: bmc.DirectoryService{
+				Type:                   bmc.DirectoryServiceLDAP,
+				Enabled:                true,
+				ServiceAddresses:       []string{"ldaps://ldap.example.com"},
+				BaseDistinguishedNames: []string{"dc=example,dc=com"},
+				UsernameAttribute:      "uid",
+				GroupsAttribute:        "memberOf",
+				BindUsername:           "cn=bmc,dc=example,dc=com",
+				BindPassword:           "secret",
+				RoleMappings: []bmc.DirectoryRoleMapping{
+					{RemoteGroup: "cn=bmc-admins,dc=example,dc=com", LocalRole: "Administrator"},
+				},
+			},
+			expect: map[string]interface{}{
+				"LDAP": map[string]interface{}{
+					"ServiceEnabled":   true,
+					"ServiceAddresses": []interface{}{"ldaps://ldap.example.com"},
+					"Authentication": map[string]interface{}{
+						"AuthenticationType": "UsernameAndPassword",
+						"Username":           "cn=bmc,dc=example,dc=com",
+						"Password":           "secret",
+					},
+					"LDAPService": map[string]interface{}{
+						"SearchSettings": map[string]interface{}{
+							"BaseDistinguishedNames": []interface{}{"dc=example,dc=com"},
+							"UsernameAttribute":      "uid",
+							"GroupsAttribute":        "memberOf",
+						},
+					},
+					"RemoteRoleMapping": []interface{}{
+						map[string]interface{}{"RemoteGroup": "cn=bmc-admins,dc=example,dc=com", "LocalRole": "Administrator"},
+					},
+				},
+			},
+		},
+		"disable active directory and clear mappings": {
+			service: bmc.DirectoryService{
+				Type:         bmc.DirectoryServiceActiveDirectory,
+				RoleMappings: []bmc.DirectoryRoleMapping{},
+			},
+			expect: map[string]interface{}{
+				"ActiveDirectory": map[string]interface{}{
+					"ServiceEnabled":    false,
+					"RemoteRoleMapping": []interface{}{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]map[string]interface{}{}
+
+			client, closeFn := directoryServiceClient(t, "accounts/accountservice.json", patched)
+			defer closeFn()
+
+			err := client.SetDirectoryService(context.TODO(), tc.service)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched["/redfish/v1/AccountService"])
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+const (
+	// defaultEventSubscriptionContext is set on subscriptions created without a Context, since the Context is required by redfish.
+	defaultEventSubscriptionContext = "bmclib"
+)
+
+// eventSubscriptionPayload is the redfish EventDestination create payload.
+//
+// The gofish create methods are not used here since those do not accept the deprecated EventTypes
+// along with the RegistryPrefixes and ResourceTypes, which is required by some BMCs.
+type eventSubscriptionPayload struct {
+	Destination      string   `json:"Destination"`
+	Protocol         string   `json:"Protocol"`
+	Context          string   `json:"Context"`
+	EventTypes       []string `json:"EventTypes,omitempty"`
+	RegistryPrefixes []string `json:"RegistryPrefixes,omitempty"`
+	ResourceTypes    []string `json:"ResourceTypes,omitempty"`
+}
+
+// EventService returns the redfish EventService.
+func (c *Client) EventService() (*schemas.EventService, error) {
+	if err := c.SessionActive(); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	eventService, err := c.client.Service.EventService()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+
+	return eventService, nil
+}
+
+// CreateEventSubscription creates an EventService subscription and returns the subscription URI.
+func (c *Client) CreateEventSubscription(_ context.Context, subscription bmc.EventSubscription) (string, error) {
+	destination, err := url.ParseRequestURI(subscription.Destination)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishEventService, "invalid destination: "+err.Error())
+	}
+
+	if destination.Scheme != "http" && destination.Scheme != "https" {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishEventService, "destination scheme must be http or https: "+subscription.Destination)
+	}
+
+	eventService, err := c.EventService()
+	if err != nil {
+		return "", err
+	}
+
+	if eventService.SubscriptionsLink == "" {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishEventService, "no subscriptions link in the event service")
+	}
+
+	payload := &eventSubscriptionPayload{
+		Destination:      subscription.Destination,
+		Protocol:         string(schemas.RedfishEventDestinationProtocol),
+		Context:          subscription.Context,
+		EventTypes:       subscription.EventTypes,
+		RegistryPrefixes: subscription.RegistryPrefixes,
+		ResourceTypes:    subscription.ResourceTypes,
+	}
+
+	if payload.Context == "" {
+		payload.Context = defaultEventSubscriptionContext
+	}
+
+	resp, err := c.client.Post(eventService.SubscriptionsLink, payload)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+	defer resp.Body.Close()
+
+	// the Location header is returned as a URI or a URL
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishEventService, "no subscription location returned")
+	}
+
+	if u, err := url.Parse(location); err == nil && u.Path != "" {
+		location = u.Path
+	}
+
+	return location, nil
+}
+
+// EventSubscriptions returns the EventService subscriptions.
+func (c *Client) EventSubscriptions(_ context.Context) ([]bmc.EventSubscription, error) {
+	eventService, err := c.EventService()
+	if err != nil {
+		return nil, err
+	}
+
+	destinations, err := eventService.Subscriptions()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+
+	subscriptions := make([]bmc.EventSubscription, 0, len(destinations))
+	for _, d := range destinations {
+		subscription := bmc.EventSubscription{
+			ID:               d.ODataID,
+			Destination:      d.Destination,
+			RegistryPrefixes: d.RegistryPrefixes,
+			ResourceTypes:    d.ResourceTypes,
+			Context:          d.Context,
+		}
+
+		for _, eventType := range d.EventTypes {
+			subscription.EventTypes = append(subscription.EventTypes, string(eventType))
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteEventSubscription deletes the EventService subscription identified by its URI.
+func (c *Client) DeleteEventSubscription(_ context.Context, id string) error {
+	if !strings.HasPrefix(id, "/redfish/") {
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, "expected a subscription URI, got: "+id)
+	}
+
+	eventService, err := c.EventService()
+	if err != nil {
+		return err
+	}
+
+	if err := eventService.DeleteEventSubscription(id); err != nil {
+		return errors.Wrap(bmclibErrs.ErrRedfishEventService, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func eventServiceClient(t *testing.T, subscriptionsHandler http.HandlerFunc) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                             endpointFunc(t, "serviceroot.json"),
+		"/redfish/v1/EventService":                 endpointFunc(t, "events/eventservice.json"),
+		"/redfish/v1/EventService/Subscriptions":   subscriptionsHandler,
+		"/redfish/v1/EventService/Subscriptions/1": endpointFunc(t, "events/subscriptions_1.json"),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestCreateEventSubscription(t *testing.T) {
+	tests := map[string]struct {
+		subscription  bmc.EventSubscription
+		expectPayload map[string]interface{}
+		expectID      string
+		err           error
+	}{
+		"registry prefixes with default context": {
+			subscription: bmc.EventSubscription{
+				Destination:      "https://192.0.2.10:8443/events",
+				RegistryPrefixes: []string{"iDRAC"},
+			},
+			expectPayload: map[string]interface{}{
+				"Destination":      "https://192.0.2.10:8443/events",
+				"Protocol":         "Redfish",
+				"Context":          "bmclib",
+				"RegistryPrefixes": []interface{}{"iDRAC"},
+			},
+			expectID: "/redfish/v1/EventService/Subscriptions/2",
+		},
+		"event types": {
+			subscription: bmc.EventSubscription{
+				Destination: "http://192.0.2.10/events",
+				EventTypes:  []string{"Alert", "StatusChange"},
+				Context:     "foo",
+			},
+			expectPayload: map[string]interface{}{
+				"Destination": "http://192.0.2.10/events",
+				"Protocol":    "Redfish",
+				"Context":     "foo",
+				"EventTypes":  []interface{}{"Alert", "StatusChange"},
+			},
+			expectID: "/redfish/v1/EventService/Subscriptions/2",
+		},
+		"invalid destination": {
+			subscription: bmc.EventSubscription{
+				Destination: "syslog://192.0.2.10",
+			},
+			err: bmclibErrs.ErrRedfishEventService,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subscriptionsHandler := func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					_, _ = w.Write(mustReadFile(t, "events/subscriptions.json"))
+					return
+				}
+
+				payload := map[string]interface{}{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(t, tc.expectPayload, payload)
+
+				// returned as a URL by some BMCs
+				w.Header().Set("Location", "https://"+r.Host+"/redfish/v1/EventService/Subscriptions/2")
+				w.WriteHeader(http.StatusCreated)
+			}
+
+			client, closeFn := eventServiceClient(t, subscriptionsHandler)
+			defer closeFn()
+
+			id, err := client.CreateEventSubscription(context.TODO(), tc.subscription)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectID, id)
+		})
+	}
+}
+
+func TestEventSubscriptions(t *testing.T) {
+	client, closeFn := eventServiceClient(t, endpointFunc(t, "events/subscriptions.json"))
+	defer closeFn()
+
+	subscriptions, err := client.EventSubscriptions(context.TODO())
+	assert.Nil(t, err)
+
+	expected := []bmc.EventSubscription{
+		{
+			ID:               "/redfish/v1/EventService/Subscriptions/1",
+			Destination:      "https://192.0.2.10:8443/events",
+			EventTypes:       []string{"Alert"},
+			RegistryPrefixes: []string{"iDRAC"},
+			ResourceTypes:    []string{},
+			Context:          "bmclib",
+		},
+	}
+
+	assert.Equal(t, expected, subscriptions)
+}
+
+func TestDeleteEventSubscription(t *testing.T) {
+	client, closeFn := eventServiceClient(t, endpointFunc(t, "events/subscriptions.json"))
+	defer closeFn()
+
+	err := client.DeleteEventSubscription(context.TODO(), "/redfish/v1/EventService/Subscriptions/1")
+	assert.Nil(t, err)
+
+	err = client.DeleteEventSubscription(context.TODO(), "1")
+	assert.ErrorIs(t, err, bmclibErrs.ErrRedfishEventService)
+}
@@ -9,8 +9,6 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +18,7 @@ import (
 
 	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/internal"
 )
 
 type installMethod string
@@ -39,8 +38,12 @@ var (
 	errMultiPartPayload   = errors.New("error preparing multipart payload")
 	errUpdateParams       = errors.New("error in redfish UpdateParameters payload")
 	errTaskIdFromRespBody = errors.New("failed to identify firmware install taskID from response body")
+	errUpdateFileSize     = errors.New("firmware update payload size required")
 )
 
+// defaultUpdateFileName is the multipart form file name used when the update payload reader is not named.
+const defaultUpdateFileName = "firmware.bin"
+
 type RedfishUpdateServiceParameters struct {
 	Targets            []string                     `json:"Targets"`
 	OperationApplyTime constants.OperationApplyTime `json:"@Redfish.OperationApplyTime"`
@@ -48,7 +51,13 @@ type RedfishUpdateServiceParameters struct {
 }
 
 // FirmwareUpload uploads and initiates the firmware install process
-func (c *Client) FirmwareUpload(ctx context.Context, updateFile *os.File, params *RedfishUpdateServiceParameters) (taskID string, err error) {
+//
+// The update payload is streamed from the reader to the BMC, size is the number of bytes expected to be read from it.
+func (c *Client) FirmwareUpload(ctx context.Context, updateFile io.Reader, size int64, params *RedfishUpdateServiceParameters) (taskID string, err error) {
+	if size <= 0 {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, errUpdateFileSize.Error())
+	}
+
 	parameters, err := json.Marshal(params)
 	if err != nil {
 		return "", errors.Wrap(errUpdateParams, err.Error())
@@ -76,14 +85,14 @@ func (c *Client) FirmwareUpload(ctx context.Context, updateFile *os.File, params
 	switch installMethod {
 	case multipartHttpUpload:
 		var uploadErr error
-		resp, uploadErr = c.multipartHTTPUpload(installURI, updateFile, parameters)
+		resp, uploadErr = c.multipartHTTPUpload(installURI, updateFile, size, parameters)
 		if uploadErr != nil {
 			return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, uploadErr.Error())
 		}
 
 	case unstructuredHttpPush:
 		var uploadErr error
-		resp, uploadErr = c.unstructuredHttpUpload(installURI, updateFile)
+		resp, uploadErr = c.unstructuredHttpUpload(installURI, updateFile, size)
 		if uploadErr != nil {
 			return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, uploadErr.Error())
 		}
@@ -236,10 +245,11 @@ func taskIDFromLocationHeader(uri string) (taskID string, err error) {
 
 type multipartPayload struct {
 	updateParameters []byte
-	updateFile       *os.File
+	updateFile       io.Reader
+	updateFileSize   int64
 }
 
-func (c *Client) multipartHTTPUpload(url string, update *os.File, params []byte) (*http.Response, error) {
+func (c *Client) multipartHTTPUpload(url string, update io.Reader, size int64, params []byte) (*http.Response, error) {
 	if url == "" {
 		return nil, fmt.Errorf("unable to execute request, no target provided")
 	}
@@ -248,22 +258,27 @@ func (c *Client) multipartHTTPUpload(url string, update *os.File, params []byte)
 	payload := &multipartPayload{
 		updateParameters: params,
 		updateFile:       update,
+		updateFileSize:   size,
 	}
 
 	return c.runRequestWithMultipartPayload(url, payload)
 }
 
-func (c *Client) unstructuredHttpUpload(url string, update io.Reader) (*http.Response, error) {
+func (c *Client) unstructuredHttpUpload(url string, update io.Reader, size int64) (*http.Response, error) {
 	if url == "" {
 		return nil, fmt.Errorf("unable to execute request, no target provided")
 	}
 
-	// TODO: transform this to read the update so that we don't hold the data in memory
-	b, _ := io.ReadAll(update)
-	payloadReadSeeker := bytes.NewReader(b)
+	// The Content-Length is set explicitly so the update is streamed to the BMC
+	// instead of being sent with the 'chunked' Transfer-Encoding.
+	headers := map[string]string{
+		"Content-Length": strconv.FormatInt(size, 10),
+	}
 
-	return c.RunRawRequestWithHeaders(http.MethodPost, url, payloadReadSeeker, "application/octet-stream", nil)
+	// update wrapped as a io.ReadSeeker to satisfy the gofish method signature
+	reader := readerFakeSeeker{update}
 
+	return c.RunRawRequestWithHeaders(http.MethodPost, url, reader, "application/octet-stream", headers)
 }
 
 // firmwareUpdateMethodURI returns the updateMethod and URI
@@ -303,24 +318,24 @@ func updateParametersFormField(fieldName string, writer *multipart.Writer) (io.W
 	return writer.CreatePart(h)
 }
 
-// pipeReaderFakeSeeker wraps the io.PipeReader and implements the io.Seeker interface
+// readerFakeSeeker wraps an io.Reader and implements the io.Seeker interface
 // to meet the API requirements for the Gofish client https://github.com/stmcginnis/gofish/blob/46b1b33645ed1802727dc4df28f5d3c3da722b15/client.go#L434
 //
 // The Gofish method linked does not currently perform seeks and so a PR will be suggested
 // to change the method signature to accept an io.Reader instead.
-type pipeReaderFakeSeeker struct {
-	*io.PipeReader
+type readerFakeSeeker struct {
+	io.Reader
 }
 
-// Seek impelements the io.Seeker interface only to panic if called
-func (p pipeReaderFakeSeeker) Seek(offset int64, whence int) (int64, error) {
-	return 0, errors.New("Seek() not implemented for fake pipe reader seeker.")
+// Seek impelements the io.Seeker interface only to return an error if called
+func (p readerFakeSeeker) Seek(offset int64, whence int) (int64, error) {
+	return 0, errors.New("Seek() not implemented for fake reader seeker.")
 }
 
 // multipartPayloadSize prepares a temporary multipart form to determine the form size
 //
 // It creates a temporary form without reading in the update file payload and returns
-// sizeOf(form) + sizeOf(update file)
+// sizeOf(form) + updateFileSize
 func multipartPayloadSize(payload *multipartPayload) (int64, *bytes.Buffer, error) {
 	body := &bytes.Buffer{}
 	form := multipart.NewWriter(body)
@@ -336,13 +351,7 @@ func multipartPayloadSize(payload *multipartPayload) (int64, *bytes.Buffer, erro
 	}
 
 	// Add updateFile form
-	_, err = form.CreateFormFile("UpdateFile", filepath.Base(payload.updateFile.Name()))
-	if err != nil {
-		return 0, body, err
-	}
-
-	// determine update file size
-	finfo, err := payload.updateFile.Stat()
+	_, err = form.CreateFormFile("UpdateFile", internal.ReaderFileName(payload.updateFile, defaultUpdateFileName))
 	if err != nil {
 		return 0, body, err
 	}
@@ -353,7 +362,7 @@ func multipartPayloadSize(payload *multipartPayload) (int64, *bytes.Buffer, erro
 		return 0, body, err
 	}
 
-	return int64(body.Len()) + finfo.Size(), body, nil
+	return int64(body.Len()) + payload.updateFileSize, body, nil
 }
 
 // runRequestWithMultipartPayload is a copy of https://github.com/stmcginnis/gofish/blob/main/client.go#L349
@@ -431,7 +440,7 @@ func (c *Client) runRequestWithMultipartPayload(url string, payload *multipartPa
 		}
 
 		// Add UpdateFile part
-		updateFilePart, err := form.CreateFormFile("UpdateFile", filepath.Base(payload.updateFile.Name()))
+		updateFilePart, err := form.CreateFormFile("UpdateFile", internal.ReaderFileName(payload.updateFile, defaultUpdateFileName))
 		if err != nil {
 			c.logger.Error(errMultiPartPayload, err.Error()+": UpdateFile part create error")
 
@@ -449,7 +458,7 @@ func (c *Client) runRequestWithMultipartPayload(url string, payload *multipartPa
 	}()
 
 	// pipeReader wrapped as a io.ReadSeeker to satisfy the gofish method signature
-	reader := pipeReaderFakeSeeker{pipeReader}
+	reader := readerFakeSeeker{pipeReader}
 
 	return c.RunRawRequestWithHeaders(http.MethodPost, url, reader, form.FormDataContentType(), headers)
 }
@@ -85,6 +85,7 @@ func TestRunRequestWithMultipartPayload(t *testing.T) {
 			payload: &multipartPayload{
 				updateParameters: []byte(`{"Targets":[],"@Redfish.OperationApplyTime":"OnReset","Oem":{}}`),
 				updateFile:       updateFile,
+				updateFileSize:   10,
 			},
 			err: nil,
 		},
@@ -386,10 +387,21 @@ func TestMultipartPayloadSize(t *testing.T) {
 			&multipartPayload{
 				updateParameters: updateParameters,
 				updateFile:       testfileFH,
+				updateFileSize:   10,
 			},
 			475,
 			"",
 		},
+		{
+			"content length with an unnamed reader",
+			&multipartPayload{
+				updateParameters: updateParameters,
+				updateFile:       bytes.NewReader([]byte(`HELLOWORLD`)),
+				updateFileSize:   10,
+			},
+			479,
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
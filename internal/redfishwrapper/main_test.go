@@ -1,9 +1,13 @@
 package redfishwrapper
 
 import (
+	"context"
+	"encoding/json"
 	"io"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 )
@@ -42,3 +46,66 @@ var endpointFunc = func(t *testing.T, file string) http.HandlerFunc {
 		_, _ = w.Write(mustReadFile(t, file))
 	}
 }
+
+// patchRecorder returns a handler serving the fixture on GET and recording the PATCH payloads by URI.
+func patchRecorder(t *testing.T, fixture string, patched map[string]map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			endpointFunc(t, fixture)(w, r)
+			return
+		}
+
+		payload := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+
+		patched[r.URL.Path] = payload
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// postRecorder records the payload of POST requests by the request path and accepts the request with a job.
+func postRecorder(t *testing.T, fixture string, posted map[string]map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			endpointFunc(t, fixture)(w, r)
+			return
+		}
+
+		payload := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+
+		posted[r.URL.Path] = payload
+		w.Header().Set("Location", "/redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_123")
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// newTestClient returns a client opened against a test server serving the handlers by request path,
+// along with a func to close the test server.
+func newTestClient(t *testing.T, handlers map[string]http.HandlerFunc) (*Client, func()) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for endpoint, handler := range handlers {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", WithBasicAuthEnabled(true))
+	if err := client.Open(context.Background()); err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+
+	return client, server.Close
+}
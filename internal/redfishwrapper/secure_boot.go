@@ -0,0 +1,212 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// GetSecureBoot returns the UEFI Secure Boot state from the system SecureBoot resource.
+func (c *Client) GetSecureBoot(_ context.Context) (bmc.SecureBoot, error) {
+	secureBoot, err := c.secureBoot()
+	if err != nil {
+		return bmc.SecureBoot{}, err
+	}
+
+	return bmc.SecureBoot{
+		Enabled:     secureBoot.SecureBootEnable,
+		CurrentBoot: secureBoot.SecureBootCurrentBoot == schemas.EnabledSecureBootCurrentBootType,
+		Mode:        string(secureBoot.SecureBootMode),
+	}, nil
+}
+
+// SetSecureBoot enables or disables UEFI Secure Boot from the next boot.
+func (c *Client) SetSecureBoot(ctx context.Context, enabled bool) error {
+	secureBoot, err := c.secureBoot()
+	if err != nil {
+		return err
+	}
+
+	if err := c.patch(ctx, secureBoot.ODataID, map[string]interface{}{"SecureBootEnable": enabled}); err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	return nil
+}
+
+// SetSecureBootMode puts the host in Setup mode by deleting the PK, or in User mode by restoring the default keys,
+// nothing is changed when the host is already in the mode.
+//
+// Audit and Deployed mode are entered from the host firmware, the Redfish SecureBootMode property is read only
+// and the ResetKeys action has no key reset type for these modes.
+func (c *Client) SetSecureBootMode(_ context.Context, mode string) error {
+	var resetKeysType schemas.ResetKeysType
+
+	switch mode {
+	case bmc.SecureBootSetupMode:
+		resetKeysType = schemas.DeletePKResetKeysType
+	case bmc.SecureBootUserMode:
+		resetKeysType = schemas.ResetAllKeysToDefaultResetKeysType
+	case bmc.SecureBootAuditMode, bmc.SecureBootDeployedMode:
+		return errors.Wrap(bmclibErrs.ErrNotImplemented, "secure boot mode is set from the host firmware: "+mode)
+	default:
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, "unknown secure boot mode: "+mode)
+	}
+
+	secureBoot, err := c.secureBoot()
+	if err != nil {
+		return err
+	}
+
+	if string(secureBoot.SecureBootMode) == mode {
+		return nil
+	}
+
+	if _, err := secureBoot.ResetKeys(resetKeysType); err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	return nil
+}
+
+// ResetSecureBootKeys resets all UEFI Secure Boot key databases to the default keys.
+func (c *Client) ResetSecureBootKeys(_ context.Context) error {
+	return c.resetSecureBootKeys(schemas.ResetAllKeysToDefaultResetKeysType)
+}
+
+// DeleteSecureBootKeys deletes the contents of all UEFI Secure Boot key databases, putting the system in Setup mode.
+func (c *Client) DeleteSecureBootKeys(_ context.Context) error {
+	return c.resetSecureBootKeys(schemas.DeleteAllKeysResetKeysType)
+}
+
+// SecureBootCertificates returns the certificates in the UEFI Secure Boot key database, PK, KEK, db or dbx.
+func (c *Client) SecureBootCertificates(_ context.Context, database string) ([]bmc.SecureBootCertificate, error) {
+	db, err := c.secureBootDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := db.Certificates()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	result := make([]bmc.SecureBootCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		result = append(result, bmc.SecureBootCertificate{
+			ID:                certificate.ID,
+			Database:          db.DatabaseID,
+			Subject:           certificate.Subject.CommonName,
+			Issuer:            certificate.Issuer.CommonName,
+			ValidNotBefore:    certificate.ValidNotBefore,
+			ValidNotAfter:     certificate.ValidNotAfter,
+			Fingerprint:       certificate.Fingerprint,
+			CertificateType:   string(certificate.CertificateType),
+			CertificateString: certificate.CertificateString,
+		})
+	}
+
+	return result, nil
+}
+
+// ImportSecureBootCertificate enrolls the PEM certificate in the UEFI Secure Boot key database, PK, KEK, db or dbx.
+func (c *Client) ImportSecureBootCertificate(ctx context.Context, database, certificate string) error {
+	db, err := c.secureBootDatabase(database)
+	if err != nil {
+		return err
+	}
+
+	// gofish does not expose the certificates collection link of the database
+	links := struct {
+		Certificates struct {
+			ODataID string `json:"@odata.id"`
+		} `json:"Certificates"`
+	}{}
+
+	if err := c.getResource(db.ODataID, &links); err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	if links.Certificates.ODataID == "" {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, database+": certificates are not supported")
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"CertificateString": certificate,
+		"CertificateType":   string(schemas.PEMCertificateType),
+	})
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	resp, err := c.PostWithHeaders(ctx, links.Certificates.ODataID, json.RawMessage(payload), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, "unexpected status code: "+resp.Status)
+	}
+
+	return nil
+}
+
+func (c *Client) resetSecureBootKeys(resetKeysType schemas.ResetKeysType) error {
+	secureBoot, err := c.secureBoot()
+	if err != nil {
+		return err
+	}
+
+	if _, err := secureBoot.ResetKeys(resetKeysType); err != nil {
+		return errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	return nil
+}
+
+// secureBoot returns the SecureBoot resource of the system.
+func (c *Client) secureBoot() (*schemas.SecureBoot, error) {
+	sys, err := c.System()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	secureBoot, err := sys.SecureBoot()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	if secureBoot == nil {
+		return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, "system does not list a SecureBoot resource")
+	}
+
+	return secureBoot, nil
+}
+
+// secureBootDatabase returns the UEFI Secure Boot key database by its database ID.
+func (c *Client) secureBootDatabase(database string) (*schemas.SecureBootDatabase, error) {
+	secureBoot, err := c.secureBoot()
+	if err != nil {
+		return nil, err
+	}
+
+	databases, err := secureBoot.SecureBootDatabases()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, err.Error())
+	}
+
+	for _, db := range databases {
+		if db.DatabaseID == database || db.ID == database {
+			return db, nil
+		}
+	}
+
+	return nil, errors.Wrap(bmclibErrs.ErrSecureBoot, "secure boot database not found: "+database)
+}
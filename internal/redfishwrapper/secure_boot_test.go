@@ -0,0 +1,139 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const secureBootURI = "/redfish/v1/Systems/System.Embedded.1/SecureBoot"
+
+func secureBootClient(t *testing.T, patched, posted map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	postRecorder := func(w http.ResponseWriter, r *http.Request) {
+		payload := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+
+		posted[r.URL.Path] = payload
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                  endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                           endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":         endpointFunc(t, "/dell/system.embedded.1.json"),
+		secureBootURI:                                   patchRecorder(t, "/dell/secureboot.json", patched),
+		secureBootURI + "/Actions/SecureBoot.ResetKeys": postRecorder,
+		secureBootURI + "/SecureBootDatabases":          endpointFunc(t, "/dell/secureboot_databases.json"),
+		secureBootURI + "/SecureBootDatabases/PK":       endpointFunc(t, "/dell/secureboot_databases_PK.json"),
+		secureBootURI + "/SecureBootDatabases/db":       endpointFunc(t, "/dell/secureboot_databases_db.json"),
+		secureBootURI + "/SecureBootDatabases/db/Certificates": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				postRecorder(w, r)
+				return
+			}
+
+			endpointFunc(t, "/dell/secureboot_databases_db_certificates.json")(w, r)
+		},
+		secureBootURI + "/SecureBootDatabases/db/Certificates/StdSecbootPolicy.1": endpointFunc(t, "/dell/secureboot_databases_db_certificate_1.json"),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestSecureBoot(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	posted := map[string]map[string]interface{}{}
+
+	client, closeFn := secureBootClient(t, patched, posted)
+	defer closeFn()
+
+	secureBoot, err := client.GetSecureBoot(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.SecureBoot{Enabled: false, CurrentBoot: false, Mode: bmc.SecureBootDeployedMode}, secureBoot)
+
+	assert.Nil(t, client.SetSecureBoot(context.Background(), true))
+	assert.Equal(t, map[string]interface{}{"SecureBootEnable": true}, patched[secureBootURI])
+
+	assert.Nil(t, client.ResetSecureBootKeys(context.Background()))
+	assert.Equal(t, map[string]interface{}{"ResetKeysType": "ResetAllKeysToDefault"}, posted[secureBootURI+"/Actions/SecureBoot.ResetKeys"])
+
+	assert.Nil(t, client.DeleteSecureBootKeys(context.Background()))
+	assert.Equal(t, map[string]interface{}{"ResetKeysType": "DeleteAllKeys"}, posted[secureBootURI+"/Actions/SecureBoot.ResetKeys"])
+}
+
+func TestSetSecureBootMode(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mode          string
+		resetKeysType interface{}
+		err           error
+	}{
+		{"setup mode", bmc.SecureBootSetupMode, "DeletePK", nil},
+		{"user mode", bmc.SecureBootUserMode, "ResetAllKeysToDefault", nil},
+		{"deployed mode", bmc.SecureBootDeployedMode, nil, bmclibErrs.ErrNotImplemented},
+		{"audit mode", bmc.SecureBootAuditMode, nil, bmclibErrs.ErrNotImplemented},
+		{"unknown mode", "CustomMode", nil, bmclibErrs.ErrSecureBoot},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			posted := map[string]map[string]interface{}{}
+
+			client, closeFn := secureBootClient(t, map[string]map[string]interface{}{}, posted)
+			defer closeFn()
+
+			err := client.SetSecureBootMode(context.Background(), tc.mode)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Empty(t, posted)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]interface{}{"ResetKeysType": tc.resetKeysType}, posted[secureBootURI+"/Actions/SecureBoot.ResetKeys"])
+		})
+	}
+}
+
+func TestSecureBootCertificates(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	posted := map[string]map[string]interface{}{}
+
+	client, closeFn := secureBootClient(t, patched, posted)
+	defer closeFn()
+
+	certificates, err := client.SecureBootCertificates(context.Background(), bmc.SecureBootDatabaseDB)
+	assert.Nil(t, err)
+	assert.Equal(t, []bmc.SecureBootCertificate{
+		{
+			ID:                "StdSecbootPolicy.1",
+			Database:          "db",
+			Subject:           "Microsoft Windows Production PCA 2011",
+			Issuer:            "Microsoft Root Certificate Authority 2010",
+			ValidNotBefore:    "2011-10-19T18:41:42Z",
+			ValidNotAfter:     "2026-10-19T18:51:42Z",
+			Fingerprint:       "58:0A:6F:4C:C4:E4:B6:69:B9:EB:DC:1B:2B:3E:08:7B:80:D0:67:8D",
+			CertificateType:   "PEM",
+			CertificateString: "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+		},
+	}, certificates)
+
+	pem := "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n"
+	assert.Nil(t, client.ImportSecureBootCertificate(context.Background(), bmc.SecureBootDatabaseDB, pem))
+	assert.Equal(t,
+		map[string]interface{}{"CertificateString": pem, "CertificateType": "PEM"},
+		posted[secureBootURI+"/SecureBootDatabases/db/Certificates"],
+	)
+
+	_, err = client.SecureBootCertificates(context.Background(), bmc.SecureBootDatabaseDBX)
+	assert.ErrorIs(t, err, bmclibErrs.ErrSecureBoot)
+}
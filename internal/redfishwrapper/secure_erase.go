@@ -0,0 +1,108 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+// driveActions is the SecureErase action target of a drive.
+type driveActions struct {
+	Actions struct {
+		SecureErase struct {
+			Target string `json:"target"`
+		} `json:"#Drive.SecureErase"`
+	} `json:"Actions"`
+}
+
+// SecureErase securely erases the drives, the whole system erase is vendor specific and not supported.
+func (c *Client) SecureErase(ctx context.Context, targets []string) (taskIDs []string, err error) {
+	drives, systemComponents := bmc.SplitEraseTargets(targets)
+	if len(systemComponents) > 0 {
+		return nil, errors.Wrap(bmclibErrs.ErrNotImplemented, "system erase")
+	}
+
+	return c.SecureEraseDrives(ctx, drives)
+}
+
+// SecureEraseDrives securely erases the drives and returns the IDs of the erase tasks in the order of the drives,
+// an empty task ID is returned for a drive erased right away.
+//
+// The SecureErase action targets of all drives are looked up before any erase is started.
+func (c *Client) SecureEraseDrives(ctx context.Context, driveIDs []string) (taskIDs []string, err error) {
+	targets := make([]string, 0, len(driveIDs))
+
+	for _, driveID := range driveIDs {
+		target, err := c.secureEraseTarget(driveID)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, target)
+	}
+
+	for idx, target := range targets {
+		resp, err := c.PostWithHeaders(ctx, target, json.RawMessage(`{}`), map[string]string{"Content-Type": "application/json"})
+		if err != nil {
+			return taskIDs, errors.Wrap(bmclibErrs.ErrSecureErase, driveIDs[idx]+": "+err.Error())
+		}
+
+		taskID, err := TaskIDFromResponse(resp)
+		if err != nil {
+			return taskIDs, errors.Wrap(bmclibErrs.ErrSecureErase, driveIDs[idx]+": "+err.Error())
+		}
+
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	return taskIDs, nil
+}
+
+// EraseTaskStatus returns the status of the erase task.
+func (c *Client) EraseTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	return c.TaskStatus(ctx, taskID)
+}
+
+// secureEraseTarget returns the SecureErase action target of the drive, the drive is looked up on all storage controllers.
+func (c *Client) secureEraseTarget(driveID string) (string, error) {
+	sys, err := c.System()
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSecureErase, err.Error())
+	}
+
+	storage, err := sys.Storage()
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSecureErase, err.Error())
+	}
+
+	for _, member := range storage {
+		links := storageLinks{}
+		if err := c.getResource(member.ODataID, &links); err != nil {
+			return "", errors.Wrap(bmclibErrs.ErrSecureErase, member.ID+": "+err.Error())
+		}
+
+		for _, drive := range links.Drives {
+			if path.Base(drive.ODataID) != driveID {
+				continue
+			}
+
+			actions := driveActions{}
+			if err := c.getResource(drive.ODataID, &actions); err != nil {
+				return "", errors.Wrap(bmclibErrs.ErrSecureErase, driveID+": "+err.Error())
+			}
+
+			if actions.Actions.SecureErase.Target == "" {
+				return "", errors.Wrap(bmclibErrs.ErrSecureErase, driveID+": secure erase is not supported")
+			}
+
+			return actions.Actions.SecureErase.Target, nil
+		}
+	}
+
+	return "", errors.Wrap(bmclibErrs.ErrSecureErase, "unknown drive: "+driveID)
+}
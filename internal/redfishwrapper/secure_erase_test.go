@@ -0,0 +1,87 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDrive0Endpoint = testStorageEndpoint + "/Drives/Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"
+	testDrive1Endpoint = testStorageEndpoint + "/Drives/Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"
+)
+
+func secureEraseClient(t *testing.T, posted map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                    endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                             endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":           endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Storage":   endpointFunc(t, "/dell/storage.json"),
+		testStorageEndpoint:                               endpointFunc(t, "/dell/storage_raid.integrated.1-1.json"),
+		testDrive0Endpoint:                                endpointFunc(t, "/dell/storage_raid.integrated.1-1_drives_disk.bay.0.json"),
+		testDrive1Endpoint:                                endpointFunc(t, "/dell/storage_raid.integrated.1-1_drives_disk.bay.1.json"),
+		testDrive0Endpoint + "/Actions/Drive.SecureErase": postRecorder(t, "404", posted),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestSecureErase(t *testing.T) {
+	testCases := []struct {
+		name    string
+		targets []string
+		taskIDs []string
+		err     error
+	}{
+		{
+			"drive",
+			[]string{"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+			[]string{"JID_123"},
+			nil,
+		},
+		{
+			"secure erase not supported",
+			[]string{"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1", "Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+			nil,
+			bmclibErrs.ErrSecureErase,
+		},
+		{
+			"unknown drive",
+			[]string{"Disk.Bay.9:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+			nil,
+			bmclibErrs.ErrSecureErase,
+		},
+		{
+			"system erase",
+			[]string{"system:BIOS"},
+			nil,
+			bmclibErrs.ErrNotImplemented,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			posted := map[string]map[string]interface{}{}
+
+			client, closeFn := secureEraseClient(t, posted)
+			defer closeFn()
+
+			taskIDs, err := client.SecureErase(context.Background(), tc.targets)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				// no drive is erased unless all drives can be erased
+				assert.Empty(t, posted)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.taskIDs, taskIDs)
+			assert.Equal(t, map[string]interface{}{}, posted[testDrive0Endpoint+"/Actions/Drive.SecureErase"])
+		})
+	}
+}
@@ -21,6 +21,15 @@ func (c *Client) AccountService() (*schemas.AccountService, error) {
 	return c.client.Service.AccountService()
 }
 
+// SessionService gets the Redfish SessionService.
+func (c *Client) SessionService() (*schemas.SessionService, error) {
+	if err := c.SessionActive(); err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrNotAuthenticated, err.Error())
+	}
+
+	return c.client.Service.SessionService()
+}
+
 // UpdateService gets the update service instance.
 func (c *Client) UpdateService() (*schemas.UpdateService, error) {
 	if err := c.SessionActive(); err != nil {
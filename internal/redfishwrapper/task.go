@@ -3,6 +3,8 @@ package redfishwrapper
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"path"
 	"strings"
 
 	"github.com/bmc-toolbox/bmclib/v2/constants"
@@ -32,6 +34,22 @@ func (c *Client) Task(ctx context.Context, taskID string) (*schemas.Task, error)
 	return nil, bmclibErrs.ErrTaskNotFound
 }
 
+// TaskIDFromResponse returns the ID of the task referenced by the Location header of an accepted request,
+// no task ID is returned when the request completed right away.
+func TaskIDFromResponse(resp *http.Response) (string, error) {
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return "", nil
+	case http.StatusAccepted:
+		// the task is referenced by the Location header, /redfish/v1/TaskService/Tasks/<id>
+		return path.Base(resp.Header.Get("Location")), nil
+	default:
+		return "", errors.New("unexpected status code: " + resp.Status)
+	}
+}
+
 func (c *Client) TaskStatus(ctx context.Context, taskID string) (constants.TaskState, string, error) {
 	task, err := c.Task(ctx, taskID)
 	if err != nil {
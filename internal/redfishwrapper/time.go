@@ -0,0 +1,114 @@
+package redfishwrapper
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+// utcOffsetRegex matches a redfish DateTimeLocalOffset value - for example '+01:00', '-05:30' or 'Z'.
+var utcOffsetRegex = regexp.MustCompile(`^(Z|[+-]\d{2}:\d{2})$`)
+
+type ntpPayload struct {
+	ProtocolEnabled bool     `json:"ProtocolEnabled"`
+	NTPServers      []string `json:"NTPServers,omitempty"`
+}
+
+// GetBMCTime returns the Manager DateTime, time zone and the ManagerNetworkProtocol NTP configuration.
+func (c *Client) GetBMCTime(ctx context.Context) (bmc.BMCTime, error) {
+	manager, err := c.Manager(ctx)
+	if err != nil {
+		return bmc.BMCTime{}, errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	bmcTime := bmc.BMCTime{TimeZone: manager.TimeZoneName}
+	if bmcTime.TimeZone == "" {
+		bmcTime.TimeZone = manager.DateTimeLocalOffset
+	}
+
+	if manager.DateTime != "" {
+		bmcTime.DateTime, err = time.Parse(time.RFC3339, manager.DateTime)
+		if err != nil {
+			return bmc.BMCTime{}, errors.Wrap(bmclibErrs.ErrBMCTime, "unexpected DateTime: "+manager.DateTime)
+		}
+	}
+
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		return bmc.BMCTime{}, errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	bmcTime.NTPEnabled = networkProtocol.NTP.ProtocolEnabled
+
+	// BMCs return empty strings for the unset NTP server slots
+	for _, server := range networkProtocol.NTP.NTPServers {
+		if strings.TrimSpace(server) != "" {
+			bmcTime.NTPServers = append(bmcTime.NTPServers, server)
+		}
+	}
+
+	return bmcTime, nil
+}
+
+// SetBMCTime sets the Manager DateTime.
+func (c *Client) SetBMCTime(ctx context.Context, dateTime time.Time) error {
+	return c.patchManager(ctx, map[string]interface{}{"DateTime": dateTime.Format(time.RFC3339)})
+}
+
+// SetBMCTimeZone sets the Manager DateTimeLocalOffset when given a UTC offset, and the TimeZoneName otherwise.
+func (c *Client) SetBMCTimeZone(ctx context.Context, timeZone string) error {
+	if timeZone == "" {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, "expected a time zone")
+	}
+
+	if utcOffsetRegex.MatchString(timeZone) {
+		if timeZone == "Z" {
+			timeZone = "+00:00"
+		}
+
+		return c.patchManager(ctx, map[string]interface{}{"DateTimeLocalOffset": timeZone})
+	}
+
+	return c.patchManager(ctx, map[string]interface{}{"TimeZoneName": timeZone})
+}
+
+// SetNTPServers sets the ManagerNetworkProtocol NTP configuration, the NTP servers are left unchanged when none are given.
+func (c *Client) SetNTPServers(ctx context.Context, enabled bool, servers []string) error {
+	manager, err := c.Manager(ctx)
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	networkProtocol, err := manager.NetworkProtocol()
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	payload := map[string]interface{}{
+		"NTP": ntpPayload{ProtocolEnabled: enabled, NTPServers: servers},
+	}
+
+	if err := c.patch(ctx, networkProtocol.ODataID, payload); err != nil {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	return nil
+}
+
+func (c *Client) patchManager(ctx context.Context, payload map[string]interface{}) error {
+	manager, err := c.Manager(ctx)
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	if err := c.patch(ctx, manager.ODataID, payload); err != nil {
+		return errors.Wrap(bmclibErrs.ErrBMCTime, err.Error())
+	}
+
+	return nil
+}
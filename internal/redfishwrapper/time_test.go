@@ -0,0 +1,118 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func bmcTimeClient(t *testing.T, patched map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                           endpointFunc(t, "serviceroot.json"),
+		"/redfish/v1/Managers":                   endpointFunc(t, "managers.json"),
+		"/redfish/v1/Managers/1":                 patchRecorder(t, "managers_1.json", patched),
+		"/redfish/v1/Managers/1/NetworkProtocol": patchRecorder(t, "managers_1_networkprotocol.json", patched),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestGetBMCTime(t *testing.T) {
+	client, closeFn := bmcTimeClient(t, map[string]map[string]interface{}{})
+	defer closeFn()
+
+	got, err := client.GetBMCTime(context.TODO())
+	assert.Nil(t, err)
+
+	expected := bmc.BMCTime{
+		DateTime:   time.Date(2023, 11, 6, 14, 16, 52, 0, time.UTC),
+		TimeZone:   "+00:00",
+		NTPEnabled: true,
+		NTPServers: []string{"192.0.2.1"},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestSetBMCTime(t *testing.T) {
+	tests := map[string]struct {
+		set    func(ctx context.Context, c *Client) error
+		uri    string
+		expect map[string]interface{}
+		err    error
+	}{
+		"date time": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetBMCTime(ctx, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+			},
+			uri:    "/redfish/v1/Managers/1",
+			expect: map[string]interface{}{"DateTime": "2024-01-02T03:04:05Z"},
+		},
+		"time zone offset": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetBMCTimeZone(ctx, "+05:30")
+			},
+			uri:    "/redfish/v1/Managers/1",
+			expect: map[string]interface{}{"DateTimeLocalOffset": "+05:30"},
+		},
+		"time zone name": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetBMCTimeZone(ctx, "Europe/Amsterdam")
+			},
+			uri:    "/redfish/v1/Managers/1",
+			expect: map[string]interface{}{"TimeZoneName": "Europe/Amsterdam"},
+		},
+		"empty time zone": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetBMCTimeZone(ctx, "")
+			},
+			err: bmclibErrs.ErrBMCTime,
+		},
+		"ntp servers": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetNTPServers(ctx, true, []string{"192.0.2.1", "192.0.2.2"})
+			},
+			uri: "/redfish/v1/Managers/1/NetworkProtocol",
+			expect: map[string]interface{}{
+				"NTP": map[string]interface{}{
+					"ProtocolEnabled": true,
+					"NTPServers":      []interface{}{"192.0.2.1", "192.0.2.2"},
+				},
+			},
+		},
+		"ntp disabled": {
+			set: func(ctx context.Context, c *Client) error {
+				return c.SetNTPServers(ctx, false, nil)
+			},
+			uri: "/redfish/v1/Managers/1/NetworkProtocol",
+			expect: map[string]interface{}{
+				"NTP": map[string]interface{}{"ProtocolEnabled": false},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]map[string]interface{}{}
+
+			client, closeFn := bmcTimeClient(t, patched)
+			defer closeFn()
+
+			err := tc.set(context.TODO(), client)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched[tc.uri])
+		})
+	}
+}
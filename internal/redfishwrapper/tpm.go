@@ -0,0 +1,114 @@
+package redfishwrapper
+
+import (
+	"context"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// tpmClearAttribute is a BIOS attribute and value that schedules a TPM clear on the next reset.
+type tpmClearAttribute struct {
+	attribute string
+	value     string
+}
+
+var (
+	// tpmClearAttributes lists the BIOS attributes that clear the TPM, the Dell TPM 2.0 and TPM 1.2 attributes
+	// are listed first followed by attributes used by other Redfish implementations.
+	tpmClearAttributes = []tpmClearAttribute{
+		{"Tpm2Hierarchy", "Clear"},
+		{"TpmCommand", "Clear"},
+		{"TpmOperation", "Clear"},
+	}
+
+	// tpmPhysicalPresenceBypassAttributes skip the physical presence prompt at boot, without which the TPM clear
+	// waits on a console confirmation.
+	tpmPhysicalPresenceBypassAttributes = []tpmClearAttribute{
+		{"TpmPpiBypassClear", "Enabled"},
+	}
+)
+
+// GetTPM returns the state of the first trusted module listed by the system.
+func (c *Client) GetTPM(_ context.Context) (bmc.TPM, error) {
+	sys, err := c.System()
+	if err != nil {
+		return bmc.TPM{}, errors.Wrap(bmclibErrs.ErrTPM, err.Error())
+	}
+
+	for _, module := range sys.TrustedModules { //nolint:staticcheck
+		return bmc.TPM{
+			Present:         true,
+			Enabled:         module.Status.State == schemas.EnabledState,
+			InterfaceType:   string(module.InterfaceType),
+			FirmwareVersion: module.FirmwareVersion,
+		}, nil
+	}
+
+	return bmc.TPM{}, nil
+}
+
+// SetTPM enables or disables the TPM through the vendor neutral tpm BIOS setting, applied on the next reset.
+func (c *Client) SetTPM(ctx context.Context, enabled bool) error {
+	return c.SetBiosConfiguration(ctx, TPMBiosConfiguration(enabled), bmc.BiosApplyTime{})
+}
+
+// ClearTPM schedules a TPM clear through the vendor BIOS attributes, applied on the next reset.
+func (c *Client) ClearTPM(ctx context.Context) error {
+	biosConfig, err := c.TPMClearBiosConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.SetBiosConfiguration(ctx, biosConfig, bmc.BiosApplyTime{})
+}
+
+// TPMBiosConfiguration returns the vendor neutral BIOS setting to enable or disable the TPM.
+func TPMBiosConfiguration(enabled bool) map[string]string {
+	if enabled {
+		return map[string]string{"tpm": "Enabled"}
+	}
+
+	return map[string]string{"tpm": "Disabled"}
+}
+
+// TPMClearBiosConfiguration returns the BIOS attributes listed by the system that clear the TPM,
+// along with the attribute that skips the physical presence prompt when listed.
+func (c *Client) TPMClearBiosConfiguration(_ context.Context) (map[string]string, error) {
+	sys, err := c.System()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrTPM, err.Error())
+	}
+
+	bios, err := sys.Bios()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrTPM, err.Error())
+	}
+
+	if bios == nil {
+		return nil, errors.Wrap(bmclibErrs.ErrTPM, bmclibErrs.ErrNoBiosAttributes.Error())
+	}
+
+	biosConfig := map[string]string{}
+
+	for _, attr := range tpmClearAttributes {
+		if _, exists := bios.Attributes[attr.attribute]; exists {
+			biosConfig[attr.attribute] = attr.value
+			break
+		}
+	}
+
+	if len(biosConfig) == 0 {
+		return nil, errors.Wrap(bmclibErrs.ErrTPM, "no BIOS attribute to clear the TPM on this BMC")
+	}
+
+	for _, bypass := range tpmPhysicalPresenceBypassAttributes {
+		if _, exists := bios.Attributes[bypass.attribute]; exists {
+			biosConfig[bypass.attribute] = bypass.value
+		}
+	}
+
+	return biosConfig, nil
+}
@@ -0,0 +1,56 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+func tpmClient(t *testing.T, patched map[string]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                        endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                                 endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":               endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios":          endpointFunc(t, "/dell/bios.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Settings": patchRecorder(t, "/dell/bios_settings.json", patched),
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestGetTPM(t *testing.T) {
+	client, closeFn := tpmClient(t, map[string]map[string]interface{}{})
+	defer closeFn()
+
+	tpm, err := client.GetTPM(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.TPM{Present: true, Enabled: true, InterfaceType: "TPM2_0", FirmwareVersion: "1.3.1.0"}, tpm)
+}
+
+func TestSetTPM(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	client, closeFn := tpmClient(t, patched)
+	defer closeFn()
+
+	assert.Nil(t, client.SetTPM(context.Background(), false))
+	assert.Equal(t, map[string]interface{}{"TpmSecurity": "Off"}, patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"])
+}
+
+func TestClearTPM(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+
+	client, closeFn := tpmClient(t, patched)
+	defer closeFn()
+
+	assert.Nil(t, client.ClearTPM(context.Background()))
+	assert.Equal(t,
+		map[string]interface{}{"Tpm2Hierarchy": "Clear", "TpmPpiBypassClear": "Enabled"},
+		patched["/redfish/v1/Systems/System.Embedded.1/Bios/Settings"]["Attributes"],
+	)
+}
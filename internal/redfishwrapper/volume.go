@@ -0,0 +1,286 @@
+package redfishwrapper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+type odataID struct {
+	ODataID string `json:"@odata.id"`
+}
+
+// storageLinks are the drives and volumes collection links of a storage resource,
+// gofish does not expose the links without fetching each resource.
+type storageLinks struct {
+	Drives  []odataID `json:"Drives"`
+	Volumes odataID   `json:"Volumes"`
+}
+
+// volumeLinks are the member drive links and the Initialize action target of a volume.
+type volumeLinks struct {
+	Links struct {
+		Drives []odataID `json:"Drives"`
+	} `json:"Links"`
+	Actions struct {
+		Initialize struct {
+			Target string `json:"target"`
+		} `json:"#Volume.Initialize"`
+	} `json:"Actions"`
+}
+
+// Volumes returns the volumes configured on the storage controllers of the system.
+func (c *Client) Volumes(_ context.Context) ([]bmc.Volume, error) {
+	sys, err := c.System()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	storage, err := sys.Storage()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	volumes := []bmc.Volume{}
+
+	for _, member := range storage {
+		members, err := member.Volumes()
+		if err != nil {
+			return nil, errors.Wrap(bmclibErrs.ErrVolume, member.ID+": "+err.Error())
+		}
+
+		for _, volume := range members {
+			volumes = append(volumes, toVolume(member.ID, volume))
+		}
+	}
+
+	return volumes, nil
+}
+
+// CreateVolume creates the volume on the storage controller and returns the ID of the task creating the volume,
+// no task ID is returned when the volume is created right away.
+func (c *Client) CreateVolume(ctx context.Context, spec bmc.VolumeSpec) (taskID string, err error) {
+	if err := spec.Validate(); err != nil {
+		return "", err
+	}
+
+	storage, err := c.storage(spec.Controller)
+	if err != nil {
+		return "", err
+	}
+
+	links := storageLinks{}
+	if err := c.getResource(storage.ODataID, &links); err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	if links.Volumes.ODataID == "" {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, spec.Controller+": volumes are not supported")
+	}
+
+	drives := make([]odataID, 0, len(spec.Drives))
+	for _, driveID := range spec.Drives {
+		drive, err := storageDrive(links.Drives, driveID)
+		if err != nil {
+			return "", errors.Wrap(err, spec.Controller)
+		}
+
+		drives = append(drives, drive)
+	}
+
+	payload := map[string]interface{}{
+		"RAIDType": spec.RAIDType,
+		"Links":    map[string]interface{}{"Drives": drives},
+	}
+
+	if spec.Name != "" {
+		payload["Name"] = spec.Name
+	}
+
+	if spec.StripSizeBytes > 0 {
+		payload["StripSizeBytes"] = spec.StripSizeBytes
+	}
+
+	if spec.CapacityBytes > 0 {
+		payload["CapacityBytes"] = spec.CapacityBytes
+	}
+
+	if spec.ApplyTime != "" {
+		payload["@Redfish.OperationApplyTime"] = spec.ApplyTime
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	resp, err := c.PostWithHeaders(ctx, links.Volumes.ODataID, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	return volumeTaskID(resp)
+}
+
+// DeleteVolume deletes the volume from the storage controller and returns the ID of the task deleting the volume,
+// no task ID is returned when the volume is deleted right away.
+func (c *Client) DeleteVolume(_ context.Context, controller, volumeID string) (taskID string, err error) {
+	volume, err := c.volume(controller, volumeID)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.Delete(volume.ODataID)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	return volumeTaskID(resp)
+}
+
+// InitializeVolume erases the data on the volume, with fast only the start of the volume is erased.
+// The ID of the task initializing the volume is returned when the initialize is not done right away.
+func (c *Client) InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (taskID string, err error) {
+	volume, err := c.volume(controller, volumeID)
+	if err != nil {
+		return "", err
+	}
+
+	links := volumeLinks{}
+	if err := json.Unmarshal(volume.RawData, &links); err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	if links.Actions.Initialize.Target == "" {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, volumeID+": initialize is not supported")
+	}
+
+	initializeType := schemas.SlowInitializeType
+	if fast {
+		initializeType = schemas.FastInitializeType
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"InitializeType": initializeType})
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	resp, err := c.PostWithHeaders(ctx, links.Actions.Initialize.Target, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	return volumeTaskID(resp)
+}
+
+// SetStorageControllerMode is not part of the Redfish specification, vendors implement it through OEM extensions.
+func (c *Client) SetStorageControllerMode(_ context.Context, _ string, _ bmc.StorageControllerMode) (taskID string, err error) {
+	return "", errors.Wrap(bmclibErrs.ErrNotImplemented, "storage controller mode")
+}
+
+// VolumeTaskStatus returns the status of the task applying a volume change.
+func (c *Client) VolumeTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	return c.TaskStatus(ctx, taskID)
+}
+
+// storage returns the storage resource of the system by its ID.
+func (c *Client) storage(controller string) (*schemas.Storage, error) {
+	sys, err := c.System()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	storage, err := sys.Storage()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	for _, member := range storage {
+		if member.ID == controller {
+			return member, nil
+		}
+	}
+
+	return nil, errors.Wrap(bmclibErrs.ErrVolume, "unknown storage controller: "+controller)
+}
+
+// volume returns the volume on the storage controller by its ID.
+func (c *Client) volume(controller, volumeID string) (*schemas.Volume, error) {
+	storage, err := c.storage(controller)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := storage.Volumes()
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	for _, volume := range volumes {
+		if volume.ID == volumeID {
+			return volume, nil
+		}
+	}
+
+	return nil, errors.Wrap(bmclibErrs.ErrVolume, controller+": unknown volume: "+volumeID)
+}
+
+// storageDrive returns the link of the drive from the storage drive links by the drive ID.
+func storageDrive(drives []odataID, driveID string) (odataID, error) {
+	for _, drive := range drives {
+		if path.Base(drive.ODataID) == driveID {
+			return drive, nil
+		}
+	}
+
+	return odataID{}, errors.Wrap(bmclibErrs.ErrVolume, "unknown drive: "+driveID)
+}
+
+// volumeTaskID returns the ID of the task applying the volume change, no task ID is returned when the change is done right away.
+func volumeTaskID(resp *http.Response) (string, error) {
+	taskID, err := TaskIDFromResponse(resp)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	return taskID, nil
+}
+
+func toVolume(controller string, volume *schemas.Volume) bmc.Volume {
+	v := bmc.Volume{
+		ID:         volume.ID,
+		Name:       volume.Name,
+		Controller: controller,
+		RAIDType:   string(volume.RAIDType),
+		Health:     string(volume.Status.Health),
+		Drives:     []string{},
+	}
+
+	if volume.DisplayName != "" {
+		v.Name = volume.DisplayName
+	}
+
+	if volume.CapacityBytes != nil {
+		v.CapacityBytes = int64(*volume.CapacityBytes)
+	}
+
+	if volume.StripSizeBytes != nil {
+		v.StripSizeBytes = int64(*volume.StripSizeBytes)
+	}
+
+	links := volumeLinks{}
+	if err := json.Unmarshal(volume.RawData, &links); err == nil {
+		for _, drive := range links.Links.Drives {
+			v.Drives = append(v.Drives, path.Base(drive.ODataID))
+		}
+	}
+
+	return v
+}
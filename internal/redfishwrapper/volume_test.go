@@ -0,0 +1,170 @@
+package redfishwrapper
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testStorageEndpoint = "/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1"
+	testVolumeEndpoint  = testStorageEndpoint + "/Volumes/Disk.Virtual.0:RAID.Integrated.1-1"
+)
+
+func volumeClient(t *testing.T, posted map[string]map[string]interface{}, deleted *[]string) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]http.HandlerFunc{
+		"/redfish/v1/":                                    endpointFunc(t, "/dell/serviceroot.json"),
+		"/redfish/v1/Systems":                             endpointFunc(t, "/dell/systems.json"),
+		"/redfish/v1/Systems/System.Embedded.1":           endpointFunc(t, "/dell/system.embedded.1.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Storage":   endpointFunc(t, "/dell/storage.json"),
+		testStorageEndpoint:                               endpointFunc(t, "/dell/storage_raid.integrated.1-1.json"),
+		testStorageEndpoint + "/Volumes":                  postRecorder(t, "/dell/storage_raid.integrated.1-1_volumes.json", posted),
+		testVolumeEndpoint + "/Actions/Volume.Initialize": postRecorder(t, "404", posted),
+		testVolumeEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				*deleted = append(*deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			endpointFunc(t, "/dell/storage_raid.integrated.1-1_volumes_disk.virtual.0.json")(w, r)
+		},
+	}
+
+	return newTestClient(t, handlers)
+}
+
+func TestVolumes(t *testing.T) {
+	client, closeFn := volumeClient(t, map[string]map[string]interface{}{}, &[]string{})
+	defer closeFn()
+
+	volumes, err := client.Volumes(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []bmc.Volume{
+		{
+			ID:             "Disk.Virtual.0:RAID.Integrated.1-1",
+			Name:           "os",
+			Controller:     "RAID.Integrated.1-1",
+			RAIDType:       "RAID1",
+			CapacityBytes:  479559942144,
+			StripSizeBytes: 65536,
+			Drives: []string{
+				"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+				"Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+			},
+			Health: "OK",
+		},
+	}, volumes)
+}
+
+func TestCreateVolume(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     bmc.VolumeSpec
+		expected map[string]interface{}
+		err      error
+	}{
+		{
+			"RAID10",
+			bmc.VolumeSpec{
+				Controller: "RAID.Integrated.1-1",
+				Name:       "data",
+				RAIDType:   "RAID10",
+				Drives: []string{
+					"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+					"Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+					"Disk.Bay.2:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+					"Disk.Bay.3:Enclosure.Internal.0-1:RAID.Integrated.1-1",
+				},
+				StripSizeBytes: 262144,
+				ApplyTime:      "OnReset",
+			},
+			map[string]interface{}{
+				"Name":     "data",
+				"RAIDType": "RAID10",
+				"Links": map[string]interface{}{
+					"Drives": []interface{}{
+						map[string]interface{}{"@odata.id": testStorageEndpoint + "/Drives/Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+						map[string]interface{}{"@odata.id": testStorageEndpoint + "/Drives/Disk.Bay.1:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+						map[string]interface{}{"@odata.id": testStorageEndpoint + "/Drives/Disk.Bay.2:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+						map[string]interface{}{"@odata.id": testStorageEndpoint + "/Drives/Disk.Bay.3:Enclosure.Internal.0-1:RAID.Integrated.1-1"},
+					},
+				},
+				"StripSizeBytes":              float64(262144),
+				"@Redfish.OperationApplyTime": "OnReset",
+			},
+			nil,
+		},
+		{
+			"unknown drive",
+			bmc.VolumeSpec{Controller: "RAID.Integrated.1-1", RAIDType: "RAID0", Drives: []string{"Disk.Bay.9:Enclosure.Internal.0-1:RAID.Integrated.1-1"}},
+			nil,
+			bmclibErrs.ErrVolume,
+		},
+		{
+			"unknown controller",
+			bmc.VolumeSpec{Controller: "AHCI.Slot.1-1", RAIDType: "RAID0", Drives: []string{"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"}},
+			nil,
+			bmclibErrs.ErrVolume,
+		},
+		{
+			"too few drives",
+			bmc.VolumeSpec{Controller: "RAID.Integrated.1-1", RAIDType: "RAID5", Drives: []string{"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"}},
+			nil,
+			bmclibErrs.ErrVolume,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			posted := map[string]map[string]interface{}{}
+
+			client, closeFn := volumeClient(t, posted, &[]string{})
+			defer closeFn()
+
+			taskID, err := client.CreateVolume(context.Background(), tc.spec)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Empty(t, posted)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "JID_123", taskID)
+			assert.Equal(t, tc.expected, posted[testStorageEndpoint+"/Volumes"])
+		})
+	}
+}
+
+func TestDeleteVolume(t *testing.T) {
+	deleted := []string{}
+
+	client, closeFn := volumeClient(t, map[string]map[string]interface{}{}, &deleted)
+	defer closeFn()
+
+	taskID, err := client.DeleteVolume(context.Background(), "RAID.Integrated.1-1", "Disk.Virtual.0:RAID.Integrated.1-1")
+	assert.Nil(t, err)
+	assert.Empty(t, taskID)
+	assert.Equal(t, []string{testVolumeEndpoint}, deleted)
+
+	_, err = client.DeleteVolume(context.Background(), "RAID.Integrated.1-1", "Disk.Virtual.1:RAID.Integrated.1-1")
+	assert.ErrorIs(t, err, bmclibErrs.ErrVolume)
+}
+
+func TestInitializeVolume(t *testing.T) {
+	posted := map[string]map[string]interface{}{}
+
+	client, closeFn := volumeClient(t, posted, &[]string{})
+	defer closeFn()
+
+	taskID, err := client.InitializeVolume(context.Background(), "RAID.Integrated.1-1", "Disk.Virtual.0:RAID.Integrated.1-1", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "JID_123", taskID)
+	assert.Equal(t, map[string]interface{}{"InitializeType": "Fast"}, posted[testVolumeEndpoint+"/Actions/Volume.Initialize"])
+}
@@ -107,8 +107,15 @@ func (s *Sum) GetCurrentBiosCfg(ctx context.Context) (output string, err error)
 	return s.run(ctx, "GetCurrentBiosCfg")
 }
 
-func (s *Sum) LoadDefaultBiosCfg(ctx context.Context) (err error) {
-	_, err = s.run(ctx, "LoadDefaultBiosCfg")
+func (s *Sum) LoadDefaultBiosCfg(ctx context.Context, reboot bool) (err error) {
+	var args []string
+
+	if reboot {
+		args = append(args, "--reboot")
+	}
+
+	_, err = s.run(ctx, "LoadDefaultBiosCfg", args...)
+
 	return err
 }
 
@@ -147,72 +154,96 @@ func (s *Sum) GetBiosConfiguration(ctx context.Context) (biosConfig map[string]s
 		return nil, err
 	}
 
+	// StandardConfig returns BIOS for the legacy boot mode, which is not a value BootMode accepts
+	if mode, exists := biosConfig["boot_mode"]; exists {
+		biosConfig["boot_mode"] = bootMode(mode)
+	}
+
 	return biosConfig, nil
 }
 
-// SetBiosConfiguration set bios configuration
-func (s *Sum) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
-	vcm, err := config.NewVendorConfigManager("xml", common.VendorSupermicro, map[string]string{})
+// bootMode returns the boot mode as accepted by the VendorConfigManager, BIOS is taken to be LEGACY.
+func bootMode(mode string) string {
+	if strings.EqualFold(mode, "BIOS") {
+		return "LEGACY"
+	}
+
+	return strings.ToUpper(mode)
+}
+
+// SetBiosConfiguration set bios configuration, the host is rebooted to apply the changes when reboot is true
+func (s *Sum) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, reboot bool) (err error) {
+	xmlData, err := vendorBiosConfiguration(biosConfig)
 	if err != nil {
 		return err
 	}
 
+	return s.changeBiosCfgFromString(ctx, xmlData, reboot)
+}
+
+// vendorBiosConfiguration returns the sum BIOS configuration XML for the vendor neutral and raw: prefixed keys.
+func vendorBiosConfiguration(biosConfig map[string]string) (xmlData string, err error) {
+	vcm, err := config.NewVendorConfigManager("xml", common.VendorSupermicro, map[string]string{})
+	if err != nil {
+		return "", err
+	}
+
 	for k, v := range biosConfig {
 		switch {
 		case k == "boot_mode":
-			if err = vcm.BootMode(v); err != nil {
-				return err
+			if err = vcm.BootMode(bootMode(v)); err != nil {
+				return "", err
 			}
 		case k == "boot_order":
 			if err = vcm.BootOrder(v); err != nil {
-				return err
+				return "", err
 			}
 		case k == "intel_sgx":
 			if err = vcm.IntelSGX(v); err != nil {
-				return err
+				return "", err
 			}
 		case k == "secure_boot":
 			switch v {
 			case "Enabled":
 				if err = vcm.SecureBoot(true); err != nil {
-					return err
+					return "", err
 				}
 			case "Disabled":
 				if err = vcm.SecureBoot(false); err != nil {
-					return err
+					return "", err
 				}
 			}
 		case k == "tpm":
 			switch v {
 			case "Enabled":
 				if err = vcm.TPM(true); err != nil {
-					return err
+					return "", err
 				}
 			case "Disabled":
 				if err = vcm.TPM(false); err != nil {
-					return err
+					return "", err
 				}
 			}
 		case k == "smt":
 			switch v {
 			case "Enabled":
 				if err = vcm.SMT(true); err != nil {
-					return err
+					return "", err
 				}
 			case "Disabled":
 				if err = vcm.SMT(false); err != nil {
-					return err
+					return "", err
 				}
 			}
 		case k == "sr_iov":
 			switch v {
 			case "Enabled":
 				if err = vcm.SRIOV(true); err != nil {
-					return err
+					return "", err
 				}
 			case "Disabled":
 				if err = vcm.SRIOV(false); err != nil {
-					return err
+					return "", err
 				}
 			}
 		case strings.HasPrefix(k, "raw:"):
@@ -226,15 +257,15 @@ func (s *Sum) SetBiosConfiguration(ctx context.Context, biosConfig map[string]st
 		}
 	}
 
-	xmlData, err := vcm.Marshal()
-	if err != nil {
-		return err
-	}
-
-	return s.SetBiosConfigurationFromFile(ctx, xmlData)
+	return vcm.Marshal()
 }
 
 func (s *Sum) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
+	return s.changeBiosCfgFromString(ctx, cfg, true)
+}
+
+// changeBiosCfgFromString writes the cfg to a temporary file for sum to apply
+func (s *Sum) changeBiosCfgFromString(ctx context.Context, cfg string, reboot bool) (err error) {
 	// Open tmp file to hold cfg
 	inputConfigTmpFile, err := os.CreateTemp("", "bmclib")
 	if err != nil {
@@ -253,10 +284,10 @@ func (s *Sum) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err
 		return err
 	}
 
-	return s.ChangeBiosCfg(ctx, inputConfigTmpFile.Name(), true)
+	return s.ChangeBiosCfg(ctx, inputConfigTmpFile.Name(), reboot)
 }
 
-// ResetBiosConfiguration reset bios configuration
-func (s *Sum) ResetBiosConfiguration(ctx context.Context) (err error) {
-	return s.LoadDefaultBiosCfg(ctx)
+// ResetBiosConfiguration reset bios configuration, the host is rebooted to apply the defaults when reboot is true
+func (s *Sum) ResetBiosConfiguration(ctx context.Context, reboot bool) (err error) {
+	return s.LoadDefaultBiosCfg(ctx, reboot)
 }
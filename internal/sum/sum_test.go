@@ -2,7 +2,9 @@ package sum
 
 import (
 	"context"
+	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	ex "github.com/bmc-toolbox/bmclib/v2/internal/executor"
@@ -58,7 +60,7 @@ func TestExec_SetBiosConfiguration(t *testing.T) {
 	exec := newFakeSum(t, "SetBiosConfiguration")
 
 	// Call the SetBiosConfiguration function
-	err := exec.SetBiosConfiguration(ctx, biosConfig)
+	err := exec.SetBiosConfiguration(ctx, biosConfig, true)
 
 	// Check for any errors
 	if err != nil {
@@ -82,9 +84,61 @@ func TestExec_GetBiosConfiguration(t *testing.T) {
 		t.Errorf("Expected no error, got: %v", err)
 	}
 
-	// Confirm boot_mode exists
-	_, ok := biosConfig["boot_mode"]
-	if !ok {
-		t.Fail()
+	// Confirm boot_mode is returned in a value BootMode accepts
+	if biosConfig["boot_mode"] != "LEGACY" {
+		t.Errorf("Expected boot_mode LEGACY, got: %q", biosConfig["boot_mode"])
+	}
+}
+
+func TestExec_ResetBiosConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		reboot   bool
+		expected string
+	}{
+		{"reboot", true, "sum -i  -u  -p  -c LoadDefaultBiosCfg --reboot"},
+		{"no reboot", false, "sum -i  -u  -p  -c LoadDefaultBiosCfg"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exec := newFakeSum(t, "GetBIOSInfo")
+
+			if err := exec.ResetBiosConfiguration(context.Background(), tc.reboot); err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+			}
+
+			if got := exec.Executor.(*ex.FakeExecute).GetCmd(); got != tc.expected {
+				t.Errorf("Expected command %q, got: %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestVendorBiosConfigurationSharedKeys applies the desired state the redfish provider tests apply too.
+func TestVendorBiosConfigurationSharedKeys(t *testing.T) {
+	b, err := os.ReadFile("../../fixtures/internal/bios/desired_state.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	biosConfig := map[string]string{}
+	if err := json.Unmarshal(b, &biosConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	xmlData, err := vendorBiosConfiguration(biosConfig)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !strings.Contains(xmlData, `<Setting name="Boot mode select"`) {
+		t.Errorf("Expected the boot mode setting, got: %s", xmlData)
+	}
+
+	// BIOS, as returned by the common StandardConfig, is taken to be LEGACY
+	biosConfig["boot_mode"] = "BIOS"
+	if _, err := vendorBiosConfiguration(biosConfig); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
 	}
 }
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"io"
+	"path/filepath"
 	"unicode"
 )
 
@@ -21,3 +23,14 @@ func StringInSlice(str string, sl []string) bool {
 	}
 	return false
 }
+
+// ReaderFileName returns the base file name of the reader if it implements a Name() method like *os.File,
+// when the reader is not named the fallback value is returned.
+func ReaderFileName(r io.Reader, fallback string) string {
+	named, ok := r.(interface{ Name() string })
+	if !ok || named.Name() == "" {
+		return fallback
+	}
+
+	return filepath.Base(named.Name())
+}
@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/firmware/validate"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
+	"github.com/bmc-toolbox/bmclib/v2/internal/progress"
 	"github.com/bmc-toolbox/bmclib/v2/providers/homeassistant"
 	"github.com/bmc-toolbox/bmclib/v2/providers/rpc"
 	"github.com/go-logr/logr"
@@ -165,6 +167,39 @@ func WithHomeAssistantOpt(opt homeassistant.Config) Option {
 	}
 }
 
+// WithFirmwareUploadProgress sets a func that is invoked with the number of firmware payload bytes sent
+// and the total payload size, as firmware is uploaded with FirmwareInstall, FirmwareUpload or FirmwareInstallUploadAndInitiate.
+//
+// FirmwareInstall is not passed the payload size, the total is 0 for uploads with FirmwareInstall.
+func WithFirmwareUploadProgress(fn func(sent, total int64)) Option {
+	return func(args *Client) {
+		args.firmwareUploadProgress = progress.Func(fn)
+	}
+}
+
+// WithFirmwareValidation enables validating firmware images with the validate package before they are uploaded
+// with FirmwareUpload or FirmwareInstallUploadAndInitiate, images that don't match the expected attributes are rejected.
+//
+// The image is validated against the component passed to the upload method and the version set with ExpectFirmwareVersion,
+// an upload is rejected when these differ from a Component or Version set on expected.
+// Validation reads the whole image and requires the upload reader to implement io.ReaderAt, as *os.File does.
+func WithFirmwareValidation(expected validate.Expected) Option {
+	return func(args *Client) {
+		args.firmwareValidation = &expected
+	}
+}
+
+// FirmwareUploadOption sets an attribute of a single firmware upload.
+type FirmwareUploadOption func(*validate.Expected)
+
+// ExpectFirmwareVersion sets the version the firmware image is validated against
+// when firmware validation is enabled with WithFirmwareValidation.
+func ExpectFirmwareVersion(version string) FirmwareUploadOption {
+	return func(expected *validate.Expected) {
+		expected.Version = version
+	}
+}
+
 // WithTracerProvider specifies a tracer provider to use for creating a tracer.
 // If none is specified a noop tracerprovider is used.
 func WithTracerProvider(provider oteltrace.TracerProvider) Option {
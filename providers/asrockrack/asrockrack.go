@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
 	"github.com/bmc-toolbox/bmclib/v2/providers"
 	"github.com/bmc-toolbox/common"
 	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/jacobweinstock/registrar"
 	"github.com/pkg/errors"
 )
@@ -32,6 +37,7 @@ var (
 	Features = registrar.Features{
 		providers.FeaturePostCodeRead,
 		providers.FeatureBmcReset,
+		providers.FeatureUserRead,
 		providers.FeatureUserCreate,
 		providers.FeatureUserUpdate,
 		providers.FeatureFirmwareUpload,
@@ -41,6 +47,13 @@ var (
 		providers.FeatureInventoryRead,
 		providers.FeaturePowerSet,
 		providers.FeaturePowerState,
+		providers.FeatureBootProgress,
+	}
+
+	// redfishFeatures are implemented on the boards with an open redfish session only.
+	redfishFeatures = registrar.Features{
+		providers.FeatureFirmwareUploadInitiateInstall,
+		providers.FeatureVirtualMedia,
 	}
 )
 
@@ -56,6 +69,8 @@ type ASRockRack struct {
 	skipLogout           bool // A Close() / httpsLogout() request is ignored if the BMC was just flashed - since the sessions are terminated either way
 	log                  logr.Logger
 	httpClientSetupFuncs []func(*http.Client)
+	// redfish is set for the redfish capable boards, see redfishModels
+	redfish *redfishwrapper.Client
 }
 
 type Config struct {
@@ -113,12 +128,38 @@ func (a *ASRockRack) Name() string {
 }
 
 // Open a connection to a BMC, implements the Opener interface
+//
+// The boards identified as legacy boards through the CGI API are managed through the CGI API,
+// the redfish service is probed on other boards, so boards with redfish only firmware are supported.
 func (a *ASRockRack) Open(ctx context.Context) (err error) {
-	if err := a.httpsLogin(ctx); err != nil {
+	cgiErr := a.httpsLogin(ctx)
+	if cgiErr == nil {
+		cgiErr = a.supported(ctx)
+	}
+
+	if cgiErr == nil && !redfishCapable(a.deviceModel) {
+		return nil
+	}
+
+	if err := a.openRedfish(ctx); err != nil {
+		if cgiErr != nil {
+			return multierror.Append(cgiErr, err)
+		}
+
 		return err
 	}
 
-	return a.supported(ctx)
+	return nil
+}
+
+// Features returns the features implemented for the connected board,
+// the redfish features are included when a redfish session is open.
+func (a *ASRockRack) Features() registrar.Features {
+	if a.redfish == nil {
+		return Features
+	}
+
+	return append(append(registrar.Features{}, Features...), redfishFeatures...)
 }
 
 func (a *ASRockRack) supported(ctx context.Context) error {
@@ -150,6 +191,10 @@ func (a *ASRockRack) supported(ctx context.Context) error {
 		}
 	}
 
+	if redfishCapable(a.deviceModel) {
+		return nil
+	}
+
 	return fmt.Errorf("device model not supported: %s", a.deviceModel)
 }
 
@@ -159,7 +204,20 @@ func (a *ASRockRack) Close(ctx context.Context) (err error) {
 		return nil
 	}
 
-	return a.httpsLogout(ctx)
+	if rErr := a.closeRedfish(ctx); rErr != nil {
+		err = multierror.Append(err, rErr)
+	}
+
+	// no CGI session is open on boards with redfish only firmware
+	if a.loginSession.CSRFToken == "" {
+		return err
+	}
+
+	if lErr := a.httpsLogout(ctx); lErr != nil {
+		err = multierror.Append(err, lErr)
+	}
+
+	return err
 }
 
 // CheckCredentials verify whether the credentials are valid or not
@@ -181,3 +239,30 @@ func (a *ASRockRack) PostCode(ctx context.Context) (status string, code int, err
 
 	return status, code, nil
 }
+
+// GetBootProgress returns the host boot progress mapped from the BIOS/UEFI POST code.
+//
+// The redfish capable boards return the redfish BootProgress when the redfish service supports it.
+func (a *ASRockRack) GetBootProgress(ctx context.Context) (bmc.BootProgress, error) {
+	if a.redfish != nil {
+		progress, err := a.redfish.SystemBootProgress(ctx)
+		if err == nil {
+			return progress, nil
+		}
+
+		if !errors.Is(err, bmclibErrs.ErrRedfishVersionIncompatible) {
+			return bmc.BootProgress{}, err
+		}
+	}
+
+	status, code, err := a.PostCode(ctx)
+	if err != nil {
+		return bmc.BootProgress{}, err
+	}
+
+	return bmc.BootProgress{
+		Stage:     bmc.PostCodeBootProgressStage(status),
+		State:     fmt.Sprintf("%s (0x%02x)", status, code),
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"gopkg.in/go-playground/assert.v1"
 )
 
@@ -30,6 +31,21 @@ func TestClose(t *testing.T) {
 	}
 }
 
+func TestGetBootProgress(t *testing.T) {
+	err := aClient.httpsLogin(context.TODO())
+	if err != nil {
+		t.Errorf("login setup: %s", err.Error())
+	}
+
+	progress, err := aClient.GetBootProgress(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, bmc.BootProgressStageOSBootStarted, progress.Stage)
+	assert.Equal(t, "grub/os (0xa0)", progress.State)
+}
+
 func TestFirwmwareUpdateBMC(t *testing.T) {
 	err := aClient.httpsLogin(context.TODO())
 	if err != nil {
@@ -37,7 +53,8 @@ func TestFirwmwareUpdateBMC(t *testing.T) {
 	}
 
 	upgradeFile := "/tmp/dummy-E3C246D4I-NL_L0.01.00.ima"
-	_, err = os.Create(upgradeFile)
+	payload := []byte(`dummy firmware`)
+	err = os.WriteFile(upgradeFile, payload, 0600)
 	if err != nil {
 		t.Errorf("create file: %s", err.Error())
 	}
@@ -51,7 +68,7 @@ func TestFirwmwareUpdateBMC(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute*15)
 	defer cancel()
 
-	err = aClient.firmwareUploadBMC(ctx, fh)
+	err = aClient.firmwareUploadBMC(ctx, fh, int64(len(payload)))
 	if err != nil {
 		t.Errorf("upload: %s", err.Error())
 	}
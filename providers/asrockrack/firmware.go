@@ -3,7 +3,7 @@ package asrockrack
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
 	"strings"
 	"time"
 
@@ -28,6 +28,10 @@ func (a *ASRockRack) FirmwareInstallSteps(ctx context.Context, component string)
 		return nil, bmclibErrs.NewErrUnsupportedHardware(err.Error())
 	}
 
+	if a.redfish != nil {
+		return a.redfishFirmwareInstallSteps(component)
+	}
+
 	switch strings.ToUpper(component) {
 	case common.SlugBMC:
 		return []constants.FirmwareInstallStep{
@@ -42,19 +46,24 @@ func (a *ASRockRack) FirmwareInstallSteps(ctx context.Context, component string)
 	return nil, errors.Wrap(bmclibErrs.ErrFirmwareUpload, "component unsupported: "+component)
 }
 
-func (a *ASRockRack) FirmwareUpload(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (a *ASRockRack) FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
+	// the redfish capable boards upload and install firmware with FirmwareInstallUploadAndInitiate
+	if a.redfish != nil {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, "upload without install not supported on device model: "+a.deviceModel)
+	}
+
 	switch strings.ToUpper(component) {
 	case common.SlugBIOS:
-		return "", a.firmwareUploadBIOS(ctx, file)
+		return "", a.firmwareUploadBIOS(ctx, reader, size)
 	case common.SlugBMC:
-		return "", a.firmwareUploadBMC(ctx, file)
+		return "", a.firmwareUploadBMC(ctx, reader, size)
 	}
 
 	return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, "component unsupported: "+component)
 
 }
 
-func (a *ASRockRack) firmwareUploadBMC(ctx context.Context, file *os.File) error {
+func (a *ASRockRack) firmwareUploadBMC(ctx context.Context, reader io.Reader, size int64) error {
 	//	// expect atleast 5 minutes left in the deadline to proceed with the upload
 	d, _ := ctx.Deadline()
 	if time.Until(d) < 5*time.Minute {
@@ -81,7 +90,7 @@ func (a *ASRockRack) firmwareUploadBMC(ctx context.Context, file *os.File) error
 	}
 
 	a.log.V(2).WithValues("step", "2/4").Info("upload BMC firmware image to " + fwEndpoint)
-	err = a.uploadFirmware(ctx, fwEndpoint, file)
+	err = a.uploadFirmware(ctx, fwEndpoint, reader, size)
 	if err != nil {
 		return errors.Wrap(
 			bmclibErrs.ErrFirmwareUpload,
@@ -101,9 +110,9 @@ func (a *ASRockRack) firmwareUploadBMC(ctx context.Context, file *os.File) error
 	return nil
 }
 
-func (a *ASRockRack) firmwareUploadBIOS(ctx context.Context, file *os.File) error {
+func (a *ASRockRack) firmwareUploadBIOS(ctx context.Context, reader io.Reader, size int64) error {
 	a.log.V(2).WithValues("step", "1/3").Info("upload BIOS firmware image")
-	err := a.uploadFirmware(ctx, "api/asrr/maintenance/BIOS/firmware", file)
+	err := a.uploadFirmware(ctx, "api/asrr/maintenance/BIOS/firmware", reader, size)
 	if err != nil {
 		return errors.Wrap(
 			bmclibErrs.ErrFirmwareUpload,
@@ -134,6 +143,10 @@ func (a *ASRockRack) firmwareUploadBIOS(ctx context.Context, file *os.File) erro
 }
 
 func (a *ASRockRack) FirmwareInstallUploaded(ctx context.Context, component, uploadTaskID string) (installTaskID string, err error) {
+	if a.redfish != nil {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareInstallUploaded, "install uploaded firmware not supported on device model: "+a.deviceModel)
+	}
+
 	switch strings.ToUpper(component) {
 	case common.SlugBIOS:
 		return "", a.firmwareInstallUploadedBIOS(ctx)
@@ -176,6 +189,10 @@ func (a *ASRockRack) firmwareInstallUploadedBMC(ctx context.Context) error {
 
 // FirmwareTaskStatus returns the status of a firmware related task queued on the BMC.
 func (a *ASRockRack) FirmwareTaskStatus(ctx context.Context, kind constants.FirmwareInstallStep, component, taskID, installVersion string) (state constants.TaskState, status string, err error) {
+	if a.redfish != nil {
+		return a.redfish.TaskStatus(ctx, taskID)
+	}
+
 	component = strings.ToUpper(component)
 	switch component {
 	case common.SlugBIOS, common.SlugBMC:
@@ -224,20 +224,18 @@ func multipartSize(fieldname, filename string) int64 {
 }
 
 // 2 Upload the firmware file
-func (a *ASRockRack) uploadFirmware(ctx context.Context, endpoint string, file *os.File) error {
-	var size int64
-	finfo, err := file.Stat()
-	if err != nil {
-		return fmt.Errorf("unable to determine file size: %w", err)
+func (a *ASRockRack) uploadFirmware(ctx context.Context, endpoint string, reader io.Reader, size int64) error {
+	if size <= 0 {
+		return fmt.Errorf("unable to determine firmware payload size: %d", size)
 	}
 
-	size = finfo.Size()
-
 	fieldName, fileName := "fwimage", "image"
 	contentLength := multipartSize(fieldName, fileName) + size
 
 	// Before reading the file, rewind to the beginning
-	_, _ = file.Seek(0, 0)
+	if seeker, ok := reader.(io.Seeker); ok {
+		_, _ = seeker.Seek(0, io.SeekStart)
+	}
 
 	// setup pipe
 	pipeReader, pipeWriter := io.Pipe()
@@ -258,7 +256,7 @@ func (a *ASRockRack) uploadFirmware(ctx context.Context, endpoint string, file *
 		}
 
 		// copy from source into form part writer
-		_, err = io.Copy(part, file)
+		_, err = io.Copy(part, reader)
 		if err != nil {
 			errCh <- err
 			return
@@ -8,6 +8,10 @@ import (
 
 // Inventory returns hardware and firmware inventory
 func (a *ASRockRack) Inventory(ctx context.Context) (device *common.Device, err error) {
+	if a.redfish != nil {
+		return a.redfish.Inventory(ctx, false)
+	}
+
 	// initialize device to be populated with inventory
 	newDevice := common.NewDevice()
 	device = &newDevice
@@ -32,6 +32,10 @@ var (
 
 	// TODO: implement under rw mutex
 	httpRequestTestVar *http.Request
+	// the body of httpRequestTestVar
+	httpRequestBodyTestVar []byte
+	// the number of logins to the BMC
+	loginCountTestVar int
 )
 
 // setup test BMC
@@ -112,6 +116,7 @@ func userAccountList(w http.ResponseWriter, r *http.Request) {
 		}
 	case "PUT":
 		httpRequestTestVar = r
+		httpRequestBodyTestVar, _ = io.ReadAll(r.Body)
 	}
 }
 
@@ -303,6 +308,7 @@ func session(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			http.SetCookie(w, &http.Cookie{Name: "QSESSIONID", Value: "94ed00f482249dd77arIcp6eBBJaik", Path: "/"})
 			_, _ = w.Write(loginResponse)
+			loginCountTestVar++
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
 		}
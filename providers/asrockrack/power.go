@@ -18,6 +18,10 @@ type power struct {
 
 // PowerStateGet gets the power state of a machine
 func (a *ASRockRack) PowerStateGet(ctx context.Context) (state string, err error) {
+	if a.redfish != nil {
+		return a.redfish.SystemPowerStatus(ctx)
+	}
+
 	info, err := a.chassisStatusInfo(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401") {
@@ -62,6 +66,10 @@ func (a *ASRockRack) PowerStateGet(ctx context.Context) (state string, err error
 
 // PowerSet sets the hardware power state of a machine
 func (a *ASRockRack) PowerSet(ctx context.Context, state string) (ok bool, err error) {
+	if a.redfish != nil {
+		return a.redfish.PowerSet(ctx, state)
+	}
+
 	switch strings.ToLower(state) {
 	case "on":
 		return a.powerAction(ctx, 1)
@@ -110,8 +118,12 @@ func (a *ASRockRack) powerAction(ctx context.Context, action int) (ok bool, err
 	return true, nil
 }
 
-// BmcReset will reset the BMC - ASRR BMCs only support a cold reset.
+// BmcReset will reset the BMC - ASRR BMCs only support a cold reset, the redfish capable boards accept the redfish reset types.
 func (a *ASRockRack) BmcReset(ctx context.Context, resetType string) (ok bool, err error) {
+	if a.redfish != nil {
+		return a.redfish.BMCReset(ctx, resetType)
+	}
+
 	err = a.resetBMC(ctx)
 	if err != nil {
 		return false, err
@@ -0,0 +1,126 @@
+package asrockrack
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/bmc-toolbox/common"
+	"github.com/pkg/errors"
+)
+
+// The newer generation of ASRockRack boards ship a Redfish service,
+// these boards are managed through redfish instead of the legacy CGI API.
+//
+// board model prefixes, the redfish service is also probed on boards that are not identified through the CGI API
+var redfishModels = []string{
+	"ROMED8",
+	"B650D4U",
+	"GENOAD8X",
+}
+
+// redfishCapable returns true when the device model is a redfish capable board.
+func redfishCapable(model string) bool {
+	for _, prefix := range redfishModels {
+		if strings.HasPrefix(strings.ToUpper(model), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// openRedfish opens a redfish session, the redfish client is only kept when the session is opened.
+func (a *ASRockRack) openRedfish(ctx context.Context) error {
+	client := a.redfish
+	if client == nil {
+		client = redfishwrapper.NewClient(
+			a.ip,
+			"",
+			a.username,
+			a.password,
+			redfishwrapper.WithHTTPClient(a.httpClient),
+		)
+	}
+
+	if err := client.Open(ctx); err != nil {
+		a.redfish = nil
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "redfish: "+err.Error())
+	}
+
+	a.redfish = client
+
+	return nil
+}
+
+// closeRedfish closes the redfish session if one was opened.
+func (a *ASRockRack) closeRedfish(ctx context.Context) error {
+	if a.redfish == nil {
+		return nil
+	}
+
+	err := a.redfish.Close(ctx)
+	a.redfish = nil
+
+	return err
+}
+
+// SetVirtualMedia sets the virtual media, this is only supported on the redfish capable boards.
+func (a *ASRockRack) SetVirtualMedia(ctx context.Context, kind string, mediaURL string) (ok bool, err error) {
+	if a.redfish == nil {
+		return false, errors.Wrap(bmclibErrs.ErrNotImplemented, "virtual media not supported on device model: "+a.deviceModel)
+	}
+
+	return a.redfish.SetVirtualMedia(ctx, kind, mediaURL)
+}
+
+func (a *ASRockRack) redfishFirmwareInstallSteps(component string) ([]constants.FirmwareInstallStep, error) {
+	switch strings.ToUpper(component) {
+	case common.SlugBIOS:
+		return []constants.FirmwareInstallStep{
+			constants.FirmwareInstallStepPowerOffHost,
+			constants.FirmwareInstallStepUploadInitiateInstall,
+			constants.FirmwareInstallStepInstallStatus,
+		}, nil
+	case common.SlugBMC:
+		return []constants.FirmwareInstallStep{
+			constants.FirmwareInstallStepUploadInitiateInstall,
+			constants.FirmwareInstallStepInstallStatus,
+		}, nil
+	}
+
+	return nil, errors.Wrap(bmclibErrs.ErrFirmwareInstall, "component unsupported: "+component)
+}
+
+// FirmwareInstallUploadAndInitiate uploads and initiates the firmware install, this is only supported on the redfish capable boards.
+func (a *ASRockRack) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
+	if a.redfish == nil {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareInstall, "upload and install not supported on device model: "+a.deviceModel)
+	}
+
+	var target string
+
+	switch strings.ToUpper(component) {
+	case common.SlugBIOS:
+		target, err = a.redfish.SystemsBIOSOdataID(ctx)
+	case common.SlugBMC:
+		target, err = a.redfish.ManagerOdataID(ctx)
+	default:
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareInstall, "component unsupported: "+component)
+	}
+
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareInstall, err.Error())
+	}
+
+	params := &redfishwrapper.RedfishUpdateServiceParameters{
+		Targets:            []string{target},
+		OperationApplyTime: constants.Immediate,
+		Oem:                []byte(`{}`),
+	}
+
+	return a.redfish.FirmwareUpload(ctx, reader, size, params)
+}
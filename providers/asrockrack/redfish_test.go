@@ -0,0 +1,224 @@
+package asrockrack
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/bmc-toolbox/bmclib/v2/providers"
+	"github.com/bmc-toolbox/common"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedfishCapable(t *testing.T) {
+	testcases := []struct {
+		model  string
+		expect bool
+	}{
+		{"ROMED8-2T", true},
+		{"B650D4U", true},
+		{"GENOAD8X-2T/BCM", true},
+		{"romed8hm3", true},
+		{E3C246D4I_NL, false},
+		{"", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.model, func(t *testing.T) {
+			assert.Equal(t, tc.expect, redfishCapable(tc.model))
+		})
+	}
+}
+
+func redfishFixtureFunc(t *testing.T, file string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, err := os.ReadFile("./fixtures/ROMED8-2T/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = w.Write(b)
+	}
+}
+
+func redfishBoard(t *testing.T) (*ASRockRack, func()) {
+	t.Helper()
+
+	handlers := map[string]string{
+		"/redfish/v1/":              "serviceroot.json",
+		"/redfish/v1/Systems":       "systems.json",
+		"/redfish/v1/Systems/Self":  "systems_self.json",
+		"/redfish/v1/Managers":      "managers.json",
+		"/redfish/v1/Managers/Self": "managers_self.json",
+		"/redfish/v1/UpdateService": "updateservice.json",
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, fixture := range handlers {
+		mux.HandleFunc(endpoint, redfishFixtureFunc(t, fixture))
+	}
+
+	mux.HandleFunc("/redfish/v1/UpdateService/upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Contains(t, string(b), `"Targets":["/redfish/v1/Managers/Self"]`)
+		assert.Contains(t, string(b), `"@Redfish.OperationApplyTime":"Immediate"`)
+		assert.Contains(t, string(b), "bmc firmware")
+
+		w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewWithOptions(parsedURL.Hostname(), "foo", "bar", logr.Discard(), WithHTTPClient(server.Client()))
+	a.deviceModel = "ROMED8-2T"
+	a.redfish = redfishwrapper.NewClient(
+		parsedURL.Hostname(),
+		parsedURL.Port(),
+		"foo",
+		"bar",
+		redfishwrapper.WithHTTPClient(server.Client()),
+		redfishwrapper.WithBasicAuthEnabled(true),
+	)
+
+	if err := a.redfish.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return a, server.Close
+}
+
+func TestRedfishBoardPowerStateGet(t *testing.T) {
+	a, closeFn := redfishBoard(t)
+	defer closeFn()
+
+	state, err := a.PowerStateGet(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "On", state)
+}
+
+func TestRedfishBoardFirmwareInstallSteps(t *testing.T) {
+	testcases := []struct {
+		component string
+		expect    []constants.FirmwareInstallStep
+		err       error
+	}{
+		{
+			common.SlugBIOS,
+			[]constants.FirmwareInstallStep{
+				constants.FirmwareInstallStepPowerOffHost,
+				constants.FirmwareInstallStepUploadInitiateInstall,
+				constants.FirmwareInstallStepInstallStatus,
+			},
+			nil,
+		},
+		{
+			common.SlugBMC,
+			[]constants.FirmwareInstallStep{
+				constants.FirmwareInstallStepUploadInitiateInstall,
+				constants.FirmwareInstallStepInstallStatus,
+			},
+			nil,
+		},
+		{
+			common.SlugNIC,
+			nil,
+			bmclibErrs.ErrFirmwareInstall,
+		},
+	}
+
+	a, closeFn := redfishBoard(t)
+	defer closeFn()
+
+	for _, tc := range testcases {
+		t.Run(tc.component, func(t *testing.T) {
+			steps, err := a.FirmwareInstallSteps(context.Background(), tc.component)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, steps)
+		})
+	}
+}
+
+func TestRedfishBoardFirmwareInstallUploadAndInitiate(t *testing.T) {
+	a, closeFn := redfishBoard(t)
+	defer closeFn()
+
+	payload := []byte("bmc firmware")
+
+	taskID, err := a.FirmwareInstallUploadAndInitiate(context.Background(), common.SlugBMC, bytes.NewReader(payload), int64(len(payload)))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", taskID)
+
+	_, err = a.FirmwareUpload(context.Background(), common.SlugBMC, bytes.NewReader(payload), int64(len(payload)))
+	assert.ErrorIs(t, err, bmclibErrs.ErrFirmwareUpload)
+}
+
+func TestLegacyBoardRedfishMethods(t *testing.T) {
+	a := &ASRockRack{deviceModel: E3C246D4I_NL}
+
+	_, err := a.SetVirtualMedia(context.Background(), "CD", "http://example.com/boot.iso")
+	assert.ErrorIs(t, err, bmclibErrs.ErrNotImplemented)
+
+	_, err = a.FirmwareInstallUploadAndInitiate(context.Background(), common.SlugBMC, bytes.NewReader(nil), 0)
+	assert.ErrorIs(t, err, bmclibErrs.ErrFirmwareInstall)
+}
+
+func TestRedfishOnlyBoardOpen(t *testing.T) {
+	a, closeFn := redfishBoard(t)
+	defer closeFn()
+
+	// the CGI API is not served, the board is identified through redfish
+	a.deviceModel = ""
+
+	assert.Nil(t, a.Open(context.Background()))
+	assert.Contains(t, a.Features(), providers.FeatureVirtualMedia)
+	assert.Contains(t, a.Features(), providers.FeatureFirmwareUploadInitiateInstall)
+
+	// no CGI session was opened to be logged out
+	assert.Nil(t, a.Close(context.Background()))
+	assert.NotContains(t, a.Features(), providers.FeatureVirtualMedia)
+}
+
+func TestRedfishBoardCloseLogsOut(t *testing.T) {
+	a, closeFn := redfishBoard(t)
+	defer closeFn()
+
+	a.loginSession.CSRFToken = "l5L29IP7"
+
+	// the CGI logout is attempted after the redfish session is closed, and fails as the CGI API is not served
+	err := a.Close(context.Background())
+	assert.ErrorContains(t, err, "logging out")
+	assert.Nil(t, a.redfish)
+}
+
+func TestLegacyBoardFeatures(t *testing.T) {
+	a := &ASRockRack{deviceModel: E3C246D4I_NL}
+
+	assert.Equal(t, Features, a.Features())
+	assert.NotContains(t, a.Features(), providers.FeatureVirtualMedia)
+}
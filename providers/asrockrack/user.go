@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/bmc-toolbox/bmclib/v2/internal"
 )
@@ -72,6 +73,31 @@ func (a *ASRockRack) UserRead(ctx context.Context) (users []map[string]string, e
 	return users, nil
 }
 
+// Users returns the user accounts, including disabled accounts
+func (a *ASRockRack) Users(ctx context.Context) (users []bmc.User, err error) {
+	accounts, err := a.listUsers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrRetrievingUserAccounts, err.Error())
+	}
+
+	users = make([]bmc.User, 0)
+	for _, account := range accounts {
+		// unused account slots are listed without a name
+		if account.Name == "" {
+			continue
+		}
+
+		users = append(users, bmc.User{
+			ID:       fmt.Sprintf("%d", account.ID),
+			Username: account.Name,
+			Role:     bmc.NormalizeUserRole(account.NetworkPrivilege),
+			Enabled:  account.Access == 1,
+		})
+	}
+
+	return users, nil
+}
+
 // UserCreate adds a new user account
 func (a *ASRockRack) UserCreate(ctx context.Context, user, pass, role string) (ok bool, err error) {
 	if !internal.StringInSlice(role, validRoles) {
@@ -115,13 +141,13 @@ func (a *ASRockRack) UserCreate(ctx context.Context, user, pass, role string) (o
 
 //
 
-// UserUpdate updates a user password and role
+// UserUpdate updates a user password and role, the password is left unchanged when empty
 func (a *ASRockRack) UserUpdate(ctx context.Context, user, pass, role string) (ok bool, err error) {
 	if !internal.StringInSlice(role, validRoles) {
 		return false, bmclibErrs.ErrInvalidUserRole
 	}
 
-	if user == "" || pass == "" || role == "" {
+	if user == "" || role == "" {
 		return false, bmclibErrs.ErrUserParamsRequired
 	}
 
@@ -138,6 +164,10 @@ func (a *ASRockRack) UserUpdate(ctx context.Context, user, pass, role string) (o
 		if account.Name == user {
 			user := newUserAccount(account.ID, user, pass, role)
 
+			if pass == "" {
+				user.Changepassword = 0
+			}
+
 			user.AccessByChannel = account.AccessByChannel
 			user.PrivilegeByChannel = account.PrivilegeByChannel
 			user.Privilege = role
@@ -2,6 +2,7 @@ package asrockrack
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"os"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 )
 
@@ -86,6 +88,30 @@ func Test_UserRead(t *testing.T) {
 	assert.Equal(t, errors.Is(err, bmclibErrs.ErrRetrievingUserAccounts), true)
 }
 
+func Test_Users(t *testing.T) {
+	expected := []bmc.User{
+		{ID: "1", Username: "anonymous", Role: bmc.UserRoleAdministrator},
+		{ID: "2", Username: "admin", Role: bmc.UserRoleAdministrator, Enabled: true},
+		{ID: "3", Username: "foo", Role: bmc.UserRoleAdministrator, Enabled: true},
+	}
+
+	err := aClient.httpsLogin(context.TODO())
+	if err != nil {
+		t.Errorf("login: %s", err.Error())
+	}
+
+	logins := loginCountTestVar
+
+	users, err := aClient.Users(context.TODO())
+	if err != nil {
+		t.Error(err)
+	}
+
+	assert.Equal(t, expected, users)
+	// the open session is used
+	assert.Equal(t, logins, loginCountTestVar)
+}
+
 func Test_UserCreate(t *testing.T) {
 
 	tests := testCases
@@ -122,27 +148,40 @@ func Test_UserCreate(t *testing.T) {
 }
 
 func Test_UserUpdate(t *testing.T) {
-	tests := testCases
-	tests = append(tests,
-		[]testCase{
-			{
-				"admin",
-				"calvin",
-				"Administrator",
-				true,
-				nil,
-				"user account is updated",
-			},
-			{
-				"badmin",
-				"calvin",
-				"Administrator",
-				false,
-				bmclibErrs.ErrUserAccountNotFound,
-				"user account not present",
-			},
-		}...,
-	)
+	tests := []testCase{
+		{
+			"foo",
+			"baz",
+			"",
+			false,
+			bmclibErrs.ErrInvalidUserRole,
+			"role not defined",
+		},
+		{
+			"",
+			"calvin",
+			"Administrator",
+			false,
+			bmclibErrs.ErrUserParamsRequired,
+			"user not defined",
+		},
+		{
+			"admin",
+			"calvin",
+			"Administrator",
+			true,
+			nil,
+			"user account is updated",
+		},
+		{
+			"badmin",
+			"calvin",
+			"Administrator",
+			false,
+			bmclibErrs.ErrUserAccountNotFound,
+			"user account not present",
+		},
+	}
 
 	err := aClient.httpsLogin(context.TODO())
 	if err != nil {
@@ -156,6 +195,24 @@ func Test_UserUpdate(t *testing.T) {
 	}
 }
 
+func Test_UserUpdateRoleOnly(t *testing.T) {
+	err := aClient.httpsLogin(context.TODO())
+	if err != nil {
+		t.Error(err)
+	}
+
+	ok, err := aClient.UserUpdate(context.TODO(), "foo", "", "Operator")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// the password is left unchanged
+	account := &UserAccount{}
+	assert.Nil(t, json.Unmarshal(httpRequestBodyTestVar, account))
+	assert.Equal(t, 0, account.Changepassword)
+	assert.Equal(t, "", account.Password)
+	assert.Equal(t, "operator", account.Privilege)
+}
+
 func Test_createUser(t *testing.T) {
 	err := aClient.httpsLogin(context.TODO())
 	if err != nil {
@@ -0,0 +1,75 @@
+package dell
+
+import (
+	"context"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+// iDRAC manager attributes for the password character class requirements.
+var passwordComplexityAttributes = []string{
+	"Security.1.PasswordRequireUpperCase",
+	"Security.1.PasswordRequireNumbers",
+	"Security.1.PasswordRequireSymbols",
+}
+
+// GetAccountPolicy returns the redfish account policy,
+// the password complexity is enabled when the iDRAC requires upper case, numeric and symbol characters.
+func (c *Conn) GetAccountPolicy(ctx context.Context) (policy bmc.AccountPolicy, err error) {
+	policy, err = c.redfishwrapper.GetAccountPolicy(ctx)
+	if err != nil {
+		return policy, err
+	}
+
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return policy, errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	complexity := true
+
+	for _, name := range passwordComplexityAttributes {
+		if value, _ := attributes[name].(string); value != attributeEnabled {
+			complexity = false
+		}
+	}
+
+	policy.PasswordComplexity = &complexity
+
+	return policy, nil
+}
+
+// SetAccountPolicy sets the redfish account policy and the iDRAC password character class requirements,
+// the requirements are left as is when the password complexity is not given.
+func (c *Conn) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) (err error) {
+	// the password complexity is not part of the redfish AccountService
+	complexity := policy.PasswordComplexity
+	policy.PasswordComplexity = nil
+
+	if err := c.redfishwrapper.SetAccountPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	if complexity == nil {
+		return nil
+	}
+
+	state := attributeDisabled
+	if *complexity {
+		state = attributeEnabled
+	}
+
+	update := map[string]interface{}{}
+	for _, name := range passwordComplexityAttributes {
+		update[name] = state
+	}
+
+	if err := c.setManagerAttributes(ctx, update); err != nil {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package dell
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountPolicy(t *testing.T) {
+	client, closeFn := managerAttributesClient(t, &map[string]interface{}{})
+	defer closeFn()
+
+	policy, err := client.GetAccountPolicy(context.TODO())
+	assert.Nil(t, err)
+
+	expected := bmc.AccountPolicy{
+		MinPasswordLength:        ptr(8),
+		PasswordComplexity:       ptr(true),
+		LockoutThreshold:         ptr(0),
+		LockoutDuration:          ptr(time.Duration(0)),
+		LockoutCounterResetAfter: ptr(time.Duration(0)),
+		SessionTimeout:           ptr(30 * time.Minute),
+	}
+
+	assert.Equal(t, expected, policy)
+}
+
+func TestSetAccountPolicy(t *testing.T) {
+	patched := map[string]interface{}{}
+
+	client, closeFn := managerAttributesClient(t, &patched)
+	defer closeFn()
+
+	err := client.SetAccountPolicy(context.TODO(), bmc.AccountPolicy{MinPasswordLength: ptr(12), PasswordComplexity: ptr(false)})
+	assert.Nil(t, err)
+
+	expected := map[string]interface{}{
+		"Security.1.PasswordRequireUpperCase": "Disabled",
+		"Security.1.PasswordRequireNumbers":   "Disabled",
+		"Security.1.PasswordRequireSymbols":   "Disabled",
+	}
+
+	assert.Equal(t, expected, patched)
+}
+
+func TestSetAccountPolicyLeavesPasswordComplexity(t *testing.T) {
+	patched := map[string]interface{}{}
+
+	client, closeFn := managerAttributesClient(t, &patched)
+	defer closeFn()
+
+	err := client.SetAccountPolicy(context.TODO(), bmc.AccountPolicy{MinPasswordLength: ptr(12)})
+	assert.Nil(t, err)
+	assert.Empty(t, patched)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,204 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+)
+
+const (
+	// iDRAC manager attributes for SNMP trap alerts
+	snmpAlertAttributePrefix = "SNMPAlert."
+	snmpAlertDestination     = "Destination"
+	snmpAlertState           = "State"
+	snmpAgentCommunity       = "SNMP.1.AgentCommunity"
+
+	// ipmiLanAlertEnable is the iDRAC global IPMI LAN (PET) alert filter.
+	ipmiLanAlertEnable = "IPMILan.1.AlertEnable"
+
+	attributeEnabled  = "Enabled"
+	attributeDisabled = "Disabled"
+)
+
+var (
+	errManagerAttributes = errors.New("iDRAC manager attributes error")
+	errAlertDestination  = errors.New("alert destination error")
+	errAlertFilter       = errors.New("alert filter error")
+)
+
+// alertFilters are the iDRAC alert filters exposed through the manager attributes.
+var alertFilters = map[string]string{
+	ipmiLanAlertEnable: "IPMI over LAN platform event trap alerts",
+}
+
+// managerAttributes returns the iDRAC manager attributes.
+func (c *Conn) managerAttributes() (map[string]interface{}, error) {
+	resp, err := c.redfishwrapper.Get(redfishV1Prefix + managerAttributesEndpoint)
+	if err != nil {
+		return nil, errors.Wrap(errManagerAttributes, err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(errManagerAttributes, err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrap(errManagerAttributes, resp.Status)
+	}
+
+	data := &struct {
+		Attributes map[string]interface{} `json:"Attributes"`
+	}{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return nil, errors.Wrap(errManagerAttributes, err.Error())
+	}
+
+	return data.Attributes, nil
+}
+
+// setManagerAttributes patches the given iDRAC manager attributes.
+func (c *Conn) setManagerAttributes(ctx context.Context, attributes map[string]interface{}) error {
+	payload, err := json.Marshal(map[string]interface{}{"Attributes": attributes})
+	if err != nil {
+		return errors.Wrap(errManagerAttributes, err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PatchWithHeaders(
+		ctx,
+		redfishV1Prefix+managerAttributesEndpoint,
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return errors.Wrap(errManagerAttributes, err.Error())
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return errors.Wrap(errManagerAttributes, resp.Status)
+	}
+}
+
+// AlertDestinations returns the iDRAC SNMP trap alert destinations.
+func (c *Conn) AlertDestinations(_ context.Context) (destinations []bmc.AlertDestination, err error) {
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	community, _ := attributes[snmpAgentCommunity].(string)
+
+	for key, value := range attributes {
+		if !strings.HasPrefix(key, snmpAlertAttributePrefix) || !strings.HasSuffix(key, "."+snmpAlertDestination) {
+			continue
+		}
+
+		index := strings.TrimSuffix(strings.TrimPrefix(key, snmpAlertAttributePrefix), "."+snmpAlertDestination)
+		address, _ := value.(string)
+		state, _ := attributes[snmpAlertAttribute(index, snmpAlertState)].(string)
+
+		destinations = append(destinations, bmc.AlertDestination{
+			ID:        index,
+			Address:   address,
+			Community: community,
+			Enabled:   state == attributeEnabled,
+		})
+	}
+
+	sort.Slice(destinations, func(i, j int) bool {
+		a, _ := strconv.Atoi(destinations[i].ID)
+		b, _ := strconv.Atoi(destinations[j].ID)
+		return a < b
+	})
+
+	return destinations, nil
+}
+
+// SetAlertDestination sets an iDRAC SNMP trap alert destination.
+//
+// The SNMP community is the iDRAC agent community, and so applies to all destinations.
+func (c *Conn) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) (err error) {
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return err
+	}
+
+	if _, exists := attributes[snmpAlertAttribute(destination.ID, snmpAlertDestination)]; !exists {
+		return errors.Wrap(errAlertDestination, "unknown SNMP alert destination: "+destination.ID)
+	}
+
+	state := attributeDisabled
+	if destination.Enabled {
+		state = attributeEnabled
+	}
+
+	update := map[string]interface{}{
+		snmpAlertAttribute(destination.ID, snmpAlertState): state,
+	}
+
+	if destination.Address != "" || !destination.Enabled {
+		update[snmpAlertAttribute(destination.ID, snmpAlertDestination)] = destination.Address
+	}
+
+	if destination.Community != "" {
+		update[snmpAgentCommunity] = destination.Community
+	}
+
+	return c.setManagerAttributes(ctx, update)
+}
+
+// AlertFilters returns the iDRAC alert filters.
+func (c *Conn) AlertFilters(_ context.Context) (filters []bmc.AlertFilter, err error) {
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	for id, description := range alertFilters {
+		value, exists := attributes[id]
+		if !exists {
+			continue
+		}
+
+		filters = append(filters, bmc.AlertFilter{
+			ID:          id,
+			Description: description,
+			Enabled:     value == attributeEnabled,
+		})
+	}
+
+	sort.Slice(filters, func(i, j int) bool { return filters[i].ID < filters[j].ID })
+
+	return filters, nil
+}
+
+// SetAlertFilter enables or disables an iDRAC alert filter.
+func (c *Conn) SetAlertFilter(ctx context.Context, filter bmc.AlertFilter) (err error) {
+	if _, exists := alertFilters[filter.ID]; !exists {
+		return errors.Wrap(errAlertFilter, "unknown alert filter: "+filter.ID)
+	}
+
+	state := attributeDisabled
+	if filter.Enabled {
+		state = attributeEnabled
+	}
+
+	return c.setManagerAttributes(ctx, map[string]interface{}{filter.ID: state})
+}
+
+func snmpAlertAttribute(index, name string) string {
+	return fmt.Sprintf("%s%s.%s", snmpAlertAttributePrefix, index, name)
+}
@@ -0,0 +1,149 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func managerAttributesClient(t *testing.T, patched *map[string]interface{}) (*Conn, func()) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redfish/v1/", endpointFunc("/serviceroot.json"))
+	mux.HandleFunc("/redfish/v1/Systems", endpointFunc("/systems.json"))
+	mux.HandleFunc("/redfish/v1/Systems/System.Embedded.1", endpointFunc("/systems_embedded.1.json"))
+	// the redfish account policy updates are accepted without being recorded
+	patchAccepted := func(file string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPatch {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			endpointFunc(file)(w, r)
+		}
+	}
+
+	mux.HandleFunc("/redfish/v1/AccountService", patchAccepted("/accountservice.json"))
+	mux.HandleFunc("/redfish/v1/SessionService", patchAccepted("/sessionservice.json"))
+	mux.HandleFunc(redfishV1Prefix+managerAttributesEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			endpointFunc("/manager_attributes.json")(w, r)
+			return
+		}
+
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		payload := struct {
+			Attributes map[string]interface{} `json:"Attributes"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+
+		*patched = payload.Attributes
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(parsedURL.Hostname(), "", "", logr.Discard(), WithPort(parsedURL.Port()), WithUseBasicAuth(true))
+	if err := client.Open(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client, server.Close
+}
+
+func TestAlertDestinations(t *testing.T) {
+	client, closeFn := managerAttributesClient(t, &map[string]interface{}{})
+	defer closeFn()
+
+	destinations, err := client.AlertDestinations(context.TODO())
+	assert.Nil(t, err)
+
+	expected := []bmc.AlertDestination{
+		{ID: "1", Address: "192.0.2.10", Community: "public", Enabled: true},
+		{ID: "2", Address: "", Community: "public", Enabled: false},
+		{ID: "10", Address: "", Community: "public", Enabled: false},
+	}
+
+	assert.Equal(t, expected, destinations)
+}
+
+func TestSetAlertDestination(t *testing.T) {
+	tests := map[string]struct {
+		destination bmc.AlertDestination
+		expect      map[string]interface{}
+		err         error
+	}{
+		"enable destination": {
+			destination: bmc.AlertDestination{ID: "2", Address: "192.0.2.20", Community: "private", Enabled: true},
+			expect: map[string]interface{}{
+				"SNMPAlert.2.Destination": "192.0.2.20",
+				"SNMPAlert.2.State":       "Enabled",
+				"SNMP.1.AgentCommunity":   "private",
+			},
+		},
+		"disable destination": {
+			destination: bmc.AlertDestination{ID: "1"},
+			expect: map[string]interface{}{
+				"SNMPAlert.1.Destination": "",
+				"SNMPAlert.1.State":       "Disabled",
+			},
+		},
+		"unknown destination": {
+			destination: bmc.AlertDestination{ID: "20", Enabled: true},
+			err:         errAlertDestination,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]interface{}{}
+
+			client, closeFn := managerAttributesClient(t, &patched)
+			defer closeFn()
+
+			err := client.SetAlertDestination(context.TODO(), tc.destination)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched)
+		})
+	}
+}
+
+func TestAlertFilters(t *testing.T) {
+	patched := map[string]interface{}{}
+
+	client, closeFn := managerAttributesClient(t, &patched)
+	defer closeFn()
+
+	filters, err := client.AlertFilters(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, []bmc.AlertFilter{{ID: ipmiLanAlertEnable, Description: alertFilters[ipmiLanAlertEnable], Enabled: true}}, filters)
+
+	err = client.SetAlertFilter(context.TODO(), bmc.AlertFilter{ID: ipmiLanAlertEnable})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{ipmiLanAlertEnable: "Disabled"}, patched)
+
+	err = client.SetAlertFilter(context.TODO(), bmc.AlertFilter{ID: "foo"})
+	assert.ErrorIs(t, err, errAlertFilter)
+}
@@ -0,0 +1,126 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	jobsEndpoint = "/Managers/iDRAC.Embedded.1/Jobs"
+
+	// jobStartNow schedules the configuration job to run on the next host reboot
+	jobStartNow = "TIME_NOW"
+	// jobTimeFormat is the iDRAC job scheduling time format
+	jobTimeFormat = "2006-01-02T15:04:05"
+)
+
+// configJob is the iDRAC job queue request to apply the staged settings resource.
+type configJob struct {
+	TargetSettingsURI string `json:"TargetSettingsURI"`
+	StartTime         string `json:"StartTime"`
+	EndTime           string `json:"EndTime,omitempty"`
+}
+
+// SetBiosConfiguration stages the BIOS configuration settings and creates an iDRAC configuration job to apply them.
+//
+// The job runs on the next host reboot, with the Immediate apply time the host is reset right away
+// and with the AtMaintenanceWindowStart apply time the job is scheduled within the maintenance window,
+// converted to the iDRAC local time.
+func (c *Conn) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime bmc.BiosApplyTime) (err error) {
+	job := configJob{StartTime: jobStartNow}
+
+	switch applyTime.ApplyTime {
+	case "", constants.OnReset, constants.Immediate:
+	case constants.AtMaintenanceWindowStart:
+		location, err := c.idracLocation(ctx)
+		if err != nil {
+			return err
+		}
+
+		start := applyTime.MaintenanceWindowStart.In(location)
+		job.StartTime = start.Format(jobTimeFormat)
+		job.EndTime = start.Add(applyTime.MaintenanceWindowDuration).Format(jobTimeFormat)
+	default:
+		return errors.Wrap(bmclibErrs.ErrBiosApplyTime, string(applyTime.ApplyTime))
+	}
+
+	job.TargetSettingsURI, err = c.redfishwrapper.StageBiosConfiguration(ctx, biosConfig)
+	if err != nil {
+		return err
+	}
+
+	if job.TargetSettingsURI == "" {
+		// the attributes are already set
+		return nil
+	}
+
+	jobID, err := c.createConfigJob(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	c.Log.V(2).Info("BIOS configuration job created", "jobID", jobID)
+
+	if applyTime.ApplyTime == constants.Immediate {
+		_, err = c.redfishwrapper.PowerSet(ctx, "reset")
+	}
+
+	return err
+}
+
+// idracLocation returns the iDRAC time offset,
+// the iDRAC job scheduler takes the job start and end times in the iDRAC local time without an offset.
+func (c *Conn) idracLocation(ctx context.Context) (*time.Location, error) {
+	manager, err := c.redfishwrapper.Manager(ctx)
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrBiosApplyTime, "error querying the iDRAC time offset: "+err.Error())
+	}
+
+	if dateTime, err := time.Parse(time.RFC3339, manager.DateTime); err == nil {
+		return dateTime.Location(), nil
+	}
+
+	// the offset is given as '+01:00', '-05:30' or 'Z'
+	if offset, err := time.Parse("Z07:00", manager.DateTimeLocalOffset); err == nil {
+		return offset.Location(), nil
+	}
+
+	return nil, errors.Wrap(bmclibErrs.ErrBiosApplyTime, "unable to determine the iDRAC time offset, DateTime: "+manager.DateTime)
+}
+
+// createConfigJob queues the configuration job and returns its job ID.
+func (c *Conn) createConfigJob(ctx context.Context, job configJob) (jobID string, err error) {
+	errJob := errors.New("error creating dell configuration job")
+
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return "", errors.Wrap(errJob, err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PostWithHeaders(
+		ctx,
+		redfishV1Prefix+jobsEndpoint,
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return "", errors.Wrap(errJob, err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return "", errors.Wrap(errJob, "unexpected status code: "+resp.Status)
+	}
+
+	// the job is referenced by the Location header, /redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_<id>
+	return path.Base(resp.Header.Get("Location")), nil
+}
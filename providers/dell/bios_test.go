@@ -0,0 +1,118 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetBiosConfiguration(t *testing.T) {
+	testCases := []struct {
+		name        string
+		applyTime   bmc.BiosApplyTime
+		expectedJob *configJob
+		err         error
+	}{
+		{
+			"on reset",
+			bmc.BiosApplyTime{ApplyTime: constants.OnReset},
+			&configJob{
+				TargetSettingsURI: "/redfish/v1/Systems/System.Embedded.1/Bios/Settings",
+				StartTime:         "TIME_NOW",
+			},
+			nil,
+		},
+		{
+			"maintenance window",
+			bmc.BiosApplyTime{
+				ApplyTime:                 constants.AtMaintenanceWindowStart,
+				MaintenanceWindowStart:    time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC),
+				MaintenanceWindowDuration: 2 * time.Hour,
+			},
+			&configJob{
+				TargetSettingsURI: "/redfish/v1/Systems/System.Embedded.1/Bios/Settings",
+				// the iDRAC local time is UTC-6
+				StartTime: "2026-01-01T21:00:00",
+				EndTime:   "2026-01-01T23:00:00",
+			},
+			nil,
+		},
+		{
+			"unsupported apply time",
+			bmc.BiosApplyTime{ApplyTime: constants.OnStartUpdateRequest},
+			nil,
+			bmclibErrs.ErrBiosApplyTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var staged map[string]interface{}
+			var job *configJob
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/redfish/v1/", endpointFunc("/serviceroot.json"))
+			mux.HandleFunc("/redfish/v1/Systems", endpointFunc("/systems.json"))
+			mux.HandleFunc("/redfish/v1/Systems/System.Embedded.1", endpointFunc("/systems_embedded.1.json"))
+			mux.HandleFunc("/redfish/v1/Systems/System.Embedded.1/Bios", endpointFunc("/bios.json"))
+			mux.HandleFunc("/redfish/v1/Managers", endpointFunc("/managers.json"))
+			mux.HandleFunc("/redfish/v1/Managers/iDRAC.Embedded.1", endpointFunc("/manager.idrac.embedded.1.json"))
+			mux.HandleFunc("/redfish/v1/Systems/System.Embedded.1/Bios/Settings", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					endpointFunc("/bios_settings.json")(w, r)
+					return
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&staged); err != nil {
+					t.Fatal(err)
+				}
+
+				w.WriteHeader(http.StatusOK)
+			})
+			mux.HandleFunc(redfishV1Prefix+jobsEndpoint, func(w http.ResponseWriter, r *http.Request) {
+				job = &configJob{}
+				if err := json.NewDecoder(r.Body).Decode(job); err != nil {
+					t.Fatal(err)
+				}
+
+				w.Header().Set("Location", redfishV1Prefix+jobsEndpoint+"/JID_123")
+				w.WriteHeader(http.StatusOK)
+			})
+
+			server := httptest.NewTLSServer(mux)
+			defer server.Close()
+
+			parsedURL, err := url.Parse(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := New(parsedURL.Hostname(), "", "", logr.Discard(), WithPort(parsedURL.Port()), WithUseBasicAuth(true))
+			if err := client.Open(context.TODO()); err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.SetBiosConfiguration(context.TODO(), map[string]string{"BootMode": "Uefi"}, tc.applyTime)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Nil(t, job)
+				return
+			}
+
+			assert.Nil(t, err)
+			// the attributes are staged without an apply time for the configuration job to apply them
+			assert.Equal(t, map[string]interface{}{"Attributes": map[string]interface{}{"BootMode": "Uefi"}}, staged)
+			assert.Equal(t, tc.expectedJob, job)
+		})
+	}
+}
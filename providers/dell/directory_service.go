@@ -0,0 +1,278 @@
+package dell
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+const (
+	// iDRAC manager attributes for LDAP
+	ldapEnable         = "LDAP.1.Enable"
+	ldapServer         = "LDAP.1.Server"
+	ldapBaseDN         = "LDAP.1.BaseDN"
+	ldapUserAttribute  = "LDAP.1.UserAttribute"
+	ldapGroupAttribute = "LDAP.1.GroupAttribute"
+	ldapBindDN         = "LDAP.1.BindDN"
+	ldapBindPassword   = "LDAP.1.BindPassword"
+
+	// iDRAC manager attributes for Active Directory
+	adEnable = "ActiveDirectory.1.Enable"
+
+	// iDRAC role group and domain controller slots
+	directoryRoleGroups        = 5
+	directoryDomainControllers = 3
+)
+
+// iDRAC role group privilege bitmasks for the redfish roles.
+var directoryPrivileges = map[string]int{
+	"Administrator": 511,
+	"Operator":      499,
+	"ReadOnly":      1,
+}
+
+func ldapRoleGroupAttribute(n int, name string) string {
+	return fmt.Sprintf("LDAPRoleGroup.%d.%s", n, name)
+}
+
+func adGroupAttribute(n int, name string) string {
+	return fmt.Sprintf("ADGroup.%d.%s", n, name)
+}
+
+func adDomainControllerAttribute(n int) string {
+	return fmt.Sprintf("ActiveDirectory.1.DomainController%d", n)
+}
+
+// directoryRole returns the redfish role for the iDRAC privilege bitmask.
+func directoryRole(privilege int) string {
+	for role, p := range directoryPrivileges {
+		if p == privilege {
+			return role
+		}
+	}
+
+	return strconv.Itoa(privilege)
+}
+
+// GetDirectoryService returns the LDAP or Active Directory configuration,
+// the iDRAC manager attributes are used on firmware without the redfish AccountService LDAP and ActiveDirectory properties.
+func (c *Conn) GetDirectoryService(ctx context.Context, serviceType bmc.DirectoryServiceType) (service bmc.DirectoryService, err error) {
+	service, err = c.redfishwrapper.GetDirectoryService(ctx, serviceType)
+	if err == nil || !errors.Is(err, bmclibErrs.ErrNotImplemented) {
+		return service, err
+	}
+
+	return c.oemDirectoryService(serviceType)
+}
+
+// SetDirectoryService sets the LDAP or Active Directory configuration,
+// the iDRAC manager attributes are used on firmware without the redfish AccountService LDAP and ActiveDirectory properties.
+func (c *Conn) SetDirectoryService(ctx context.Context, service bmc.DirectoryService) (err error) {
+	err = c.redfishwrapper.SetDirectoryService(ctx, service)
+	if err == nil || !errors.Is(err, bmclibErrs.ErrNotImplemented) {
+		return err
+	}
+
+	return c.setOEMDirectoryService(ctx, service)
+}
+
+func (c *Conn) oemDirectoryService(serviceType bmc.DirectoryServiceType) (service bmc.DirectoryService, err error) {
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return service, errors.Wrap(bmclibErrs.ErrDirectoryService, err.Error())
+	}
+
+	stringAttribute := func(name string) string {
+		value, _ := attributes[name].(string)
+		return value
+	}
+
+	// numeric attributes are decoded as float64
+	privilegeAttribute := func(name string) int {
+		value, _ := attributes[name].(float64)
+		return int(value)
+	}
+
+	service.Type = serviceType
+
+	switch serviceType {
+	case bmc.DirectoryServiceLDAP:
+		service.Enabled = stringAttribute(ldapEnable) == attributeEnabled
+		service.UsernameAttribute = stringAttribute(ldapUserAttribute)
+		service.GroupsAttribute = stringAttribute(ldapGroupAttribute)
+		service.BindUsername = stringAttribute(ldapBindDN)
+
+		if server := stringAttribute(ldapServer); server != "" {
+			service.ServiceAddresses = []string{server}
+		}
+
+		if baseDN := stringAttribute(ldapBaseDN); baseDN != "" {
+			service.BaseDistinguishedNames = []string{baseDN}
+		}
+
+		for n := 1; n <= directoryRoleGroups; n++ {
+			dn := stringAttribute(ldapRoleGroupAttribute(n, "DN"))
+			if dn == "" {
+				continue
+			}
+
+			service.RoleMappings = append(service.RoleMappings, bmc.DirectoryRoleMapping{
+				RemoteGroup: dn,
+				LocalRole:   directoryRole(privilegeAttribute(ldapRoleGroupAttribute(n, "Privilege"))),
+			})
+		}
+	case bmc.DirectoryServiceActiveDirectory:
+		service.Enabled = stringAttribute(adEnable) == attributeEnabled
+
+		for n := 1; n <= directoryDomainControllers; n++ {
+			if dc := stringAttribute(adDomainControllerAttribute(n)); dc != "" {
+				service.ServiceAddresses = append(service.ServiceAddresses, dc)
+			}
+		}
+
+		for n := 1; n <= directoryRoleGroups; n++ {
+			name := stringAttribute(adGroupAttribute(n, "Name"))
+			if name == "" {
+				continue
+			}
+
+			// AD groups are returned as name@domain
+			if domain := stringAttribute(adGroupAttribute(n, "Domain")); domain != "" {
+				name = name + "@" + domain
+			}
+
+			service.RoleMappings = append(service.RoleMappings, bmc.DirectoryRoleMapping{
+				RemoteGroup: name,
+				LocalRole:   directoryRole(privilegeAttribute(adGroupAttribute(n, "Privilege"))),
+			})
+		}
+	default:
+		return service, errors.Wrap(bmclibErrs.ErrDirectoryService, "unsupported directory service type: "+string(serviceType))
+	}
+
+	return service, nil
+}
+
+func (c *Conn) setOEMDirectoryService(ctx context.Context, service bmc.DirectoryService) error {
+	if len(service.RoleMappings) > directoryRoleGroups {
+		return errors.Wrap(bmclibErrs.ErrDirectoryService, fmt.Sprintf("at most %d role mappings are supported", directoryRoleGroups))
+	}
+
+	state := attributeDisabled
+	if service.Enabled {
+		state = attributeEnabled
+	}
+
+	update := map[string]interface{}{}
+
+	// role groups beyond the given mappings are cleared
+	setRoleGroups := func(set func(n int, mapping *bmc.DirectoryRoleMapping, privilege int)) error {
+		if service.RoleMappings == nil {
+			return nil
+		}
+
+		for n := 1; n <= directoryRoleGroups; n++ {
+			if n > len(service.RoleMappings) {
+				set(n, nil, 0)
+				continue
+			}
+
+			mapping := service.RoleMappings[n-1]
+
+			privilege, exists := directoryPrivileges[mapping.LocalRole]
+			if !exists {
+				return errors.Wrap(bmclibErrs.ErrDirectoryService, "unsupported role: "+mapping.LocalRole)
+			}
+
+			set(n, &mapping, privilege)
+		}
+
+		return nil
+	}
+
+	switch service.Type {
+	case bmc.DirectoryServiceLDAP:
+		if len(service.ServiceAddresses) > 1 || len(service.BaseDistinguishedNames) > 1 {
+			return errors.Wrap(bmclibErrs.ErrDirectoryService, "a single LDAP server and base DN are supported")
+		}
+
+		update[ldapEnable] = state
+
+		if len(service.ServiceAddresses) == 1 {
+			update[ldapServer] = service.ServiceAddresses[0]
+		}
+
+		if len(service.BaseDistinguishedNames) == 1 {
+			update[ldapBaseDN] = service.BaseDistinguishedNames[0]
+		}
+
+		for name, value := range map[string]string{
+			ldapUserAttribute:  service.UsernameAttribute,
+			ldapGroupAttribute: service.GroupsAttribute,
+			ldapBindDN:         service.BindUsername,
+			ldapBindPassword:   service.BindPassword,
+		} {
+			if value != "" {
+				update[name] = value
+			}
+		}
+
+		err := setRoleGroups(func(n int, mapping *bmc.DirectoryRoleMapping, privilege int) {
+			dn := ""
+			if mapping != nil {
+				dn = mapping.RemoteGroup
+			}
+
+			update[ldapRoleGroupAttribute(n, "DN")] = dn
+			update[ldapRoleGroupAttribute(n, "Privilege")] = privilege
+		})
+		if err != nil {
+			return err
+		}
+	case bmc.DirectoryServiceActiveDirectory:
+		if len(service.ServiceAddresses) > directoryDomainControllers {
+			return errors.Wrap(bmclibErrs.ErrDirectoryService, fmt.Sprintf("at most %d domain controllers are supported", directoryDomainControllers))
+		}
+
+		update[adEnable] = state
+
+		if service.ServiceAddresses != nil {
+			for n := 1; n <= directoryDomainControllers; n++ {
+				dc := ""
+				if n <= len(service.ServiceAddresses) {
+					dc = service.ServiceAddresses[n-1]
+				}
+
+				update[adDomainControllerAttribute(n)] = dc
+			}
+		}
+
+		err := setRoleGroups(func(n int, mapping *bmc.DirectoryRoleMapping, privilege int) {
+			var name, domain string
+			if mapping != nil {
+				name, domain, _ = strings.Cut(mapping.RemoteGroup, "@")
+			}
+
+			update[adGroupAttribute(n, "Name")] = name
+			update[adGroupAttribute(n, "Domain")] = domain
+			update[adGroupAttribute(n, "Privilege")] = privilege
+		})
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.Wrap(bmclibErrs.ErrDirectoryService, "unsupported directory service type: "+string(service.Type))
+	}
+
+	if err := c.setManagerAttributes(ctx, update); err != nil {
+		return errors.Wrap(bmclibErrs.ErrDirectoryService, err.Error())
+	}
+
+	return nil
+}
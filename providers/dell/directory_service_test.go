@@ -0,0 +1,130 @@
+package dell
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+func TestGetDirectoryServiceOEM(t *testing.T) {
+	tests := map[string]struct {
+		serviceType bmc.DirectoryServiceType
+		expect      bmc.DirectoryService
+	}{
+		"ldap": {
+			serviceType: bmc.DirectoryServiceLDAP,
+			expect: bmc.DirectoryService{
+				Type:                   bmc.DirectoryServiceLDAP,
+				Enabled:                true,
+				ServiceAddresses:       []string{"ldap.example.com"},
+				BaseDistinguishedNames: []string{"dc=example,dc=com"},
+				UsernameAttribute:      "uid",
+				GroupsAttribute:        "member",
+				BindUsername:           "cn=bmc,dc=example,dc=com",
+				RoleMappings: []bmc.DirectoryRoleMapping{
+					{RemoteGroup: "cn=bmc-admins,dc=example,dc=com", LocalRole: "Administrator"},
+					{RemoteGroup: "cn=bmc-ro,dc=example,dc=com", LocalRole: "ReadOnly"},
+				},
+			},
+		},
+		"active directory": {
+			serviceType: bmc.DirectoryServiceActiveDirectory,
+			expect: bmc.DirectoryService{
+				Type:             bmc.DirectoryServiceActiveDirectory,
+				Enabled:          false,
+				ServiceAddresses: []string{"dc1.example.com"},
+				RoleMappings: []bmc.DirectoryRoleMapping{
+					{RemoteGroup: "bmc-admins@example.com", LocalRole: "Administrator"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, closeFn := managerAttributesClient(t, &map[string]interface{}{})
+			defer closeFn()
+
+			got, err := client.GetDirectoryService(context.TODO(), tc.serviceType)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestSetDirectoryServiceOEM(t *testing.T) {
+	tests := map[string]struct {
+		service bmc.DirectoryService
+		expect  map[string]interface{}
+		err     error
+	}{
+		"ldap": {
+			service: bmc.DirectoryService{
+				Type:             bmc.DirectoryServiceLDAP,
+				Enabled:          true,
+				ServiceAddresses: []string{"ldap.example.com"},
+				BindPassword:     "secret",
+				RoleMappings: []bmc.DirectoryRoleMapping{
+					{RemoteGroup: "cn=bmc-ops,dc=example,dc=com", LocalRole: "Operator"},
+				},
+			},
+			expect: map[string]interface{}{
+				"LDAP.1.Enable":             "Enabled",
+				"LDAP.1.Server":             "ldap.example.com",
+				"LDAP.1.BindPassword":       "secret",
+				"LDAPRoleGroup.1.DN":        "cn=bmc-ops,dc=example,dc=com",
+				"LDAPRoleGroup.1.Privilege": float64(499),
+				"LDAPRoleGroup.2.DN":        "",
+				"LDAPRoleGroup.2.Privilege": float64(0),
+				"LDAPRoleGroup.3.DN":        "",
+				"LDAPRoleGroup.3.Privilege": float64(0),
+				"LDAPRoleGroup.4.DN":        "",
+				"LDAPRoleGroup.4.Privilege": float64(0),
+				"LDAPRoleGroup.5.DN":        "",
+				"LDAPRoleGroup.5.Privilege": float64(0),
+			},
+		},
+		"active directory without mappings": {
+			service: bmc.DirectoryService{
+				Type:             bmc.DirectoryServiceActiveDirectory,
+				Enabled:          true,
+				ServiceAddresses: []string{"dc1.example.com", "dc2.example.com"},
+			},
+			expect: map[string]interface{}{
+				"ActiveDirectory.1.Enable":            "Enabled",
+				"ActiveDirectory.1.DomainController1": "dc1.example.com",
+				"ActiveDirectory.1.DomainController2": "dc2.example.com",
+				"ActiveDirectory.1.DomainController3": "",
+			},
+		},
+		"unsupported role": {
+			service: bmc.DirectoryService{
+				Type:         bmc.DirectoryServiceLDAP,
+				RoleMappings: []bmc.DirectoryRoleMapping{{RemoteGroup: "foo", LocalRole: "NoAccess"}},
+			},
+			err: bmclibErrs.ErrDirectoryService,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]interface{}{}
+
+			client, closeFn := managerAttributesClient(t, &patched)
+			defer closeFn()
+
+			err := client.SetDirectoryService(context.TODO(), tc.service)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched)
+		})
+	}
+}
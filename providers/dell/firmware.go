@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"time"
 
@@ -29,7 +28,7 @@ func (c *Conn) FirmwareInstallSteps(ctx context.Context, component string) ([]co
 	}, nil
 }
 
-func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
 	if err := c.deviceSupported(ctx); err != nil {
 		return "", bmcliberrs.NewErrUnsupportedHardware(err.Error())
 	}
@@ -57,7 +56,7 @@ func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component s
 		Oem:                []byte(`{}`),
 	}
 
-	return c.redfishwrapper.FirmwareUpload(ctx, file, params)
+	return c.redfishwrapper.FirmwareUpload(ctx, reader, size, params)
 }
 
 // checkQueueability returns an error if an existing firmware task is in progress for the given component
@@ -9,7 +9,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
 	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
 	"github.com/bmc-toolbox/bmclib/v2/providers"
@@ -45,7 +47,23 @@ var (
 		providers.FeatureBmcReset,
 		providers.FeatureGetBiosConfiguration,
 		providers.FeatureSetBiosConfiguration,
+		providers.FeatureSetBiosConfigurationFromFile,
+		providers.FeatureExportBiosConfiguration,
 		providers.FeatureResetBiosConfiguration,
+		providers.FeatureBiosAttributeRegistry,
+		providers.FeatureSecureBoot,
+		providers.FeatureTPM,
+		providers.FeatureVolumes,
+		providers.FeatureSecureErase,
+		providers.FeatureExportSystemConfiguration,
+		providers.FeatureImportSystemConfiguration,
+		providers.FeatureBootProgress,
+		providers.FeatureEventSubscription,
+		providers.FeatureBMCTime,
+		providers.FeatureDirectoryService,
+		providers.FeatureAccountPolicy,
+		providers.FeatureAlertDestinations,
+		providers.FeatureRemoteSyslog,
 	}
 
 	errManufacturerUnknown = errors.New("error identifying device manufacturer")
@@ -217,6 +235,46 @@ func (c *Conn) Inventory(ctx context.Context) (device *common.Device, err error)
 	return c.redfishwrapper.Inventory(ctx, false)
 }
 
+// GetBootProgress returns the host boot progress
+func (c *Conn) GetBootProgress(ctx context.Context) (bmc.BootProgress, error) {
+	return c.redfishwrapper.SystemBootProgress(ctx)
+}
+
+// CreateEventSubscription creates a BMC event subscription
+func (c *Conn) CreateEventSubscription(ctx context.Context, subscription bmc.EventSubscription) (id string, err error) {
+	return c.redfishwrapper.CreateEventSubscription(ctx, subscription)
+}
+
+// EventSubscriptions returns the BMC event subscriptions
+func (c *Conn) EventSubscriptions(ctx context.Context) (subscriptions []bmc.EventSubscription, err error) {
+	return c.redfishwrapper.EventSubscriptions(ctx)
+}
+
+// DeleteEventSubscription deletes a BMC event subscription
+func (c *Conn) DeleteEventSubscription(ctx context.Context, id string) (err error) {
+	return c.redfishwrapper.DeleteEventSubscription(ctx, id)
+}
+
+// GetBMCTime returns the BMC time, time zone and NTP configuration
+func (c *Conn) GetBMCTime(ctx context.Context) (bmcTime bmc.BMCTime, err error) {
+	return c.redfishwrapper.GetBMCTime(ctx)
+}
+
+// SetBMCTime sets the BMC date and time
+func (c *Conn) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	return c.redfishwrapper.SetBMCTime(ctx, dateTime)
+}
+
+// SetBMCTimeZone sets the BMC time zone
+func (c *Conn) SetBMCTimeZone(ctx context.Context, timeZone string) (err error) {
+	return c.redfishwrapper.SetBMCTimeZone(ctx, timeZone)
+}
+
+// SetNTPServers sets the BMC NTP configuration
+func (c *Conn) SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error) {
+	return c.redfishwrapper.SetNTPServers(ctx, enabled, servers)
+}
+
 // BmcReset power cycles the BMC
 func (c *Conn) BmcReset(ctx context.Context, resetType string) (ok bool, err error) {
 	return c.redfishwrapper.BMCReset(ctx, resetType)
@@ -227,14 +285,54 @@ func (c *Conn) GetBiosConfiguration(ctx context.Context) (biosConfig map[string]
 	return c.redfishwrapper.GetBiosConfiguration(ctx)
 }
 
-// SetBiosConfiguration sets the BIOS configuration settings via the BMC
-func (c *Conn) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
-	return c.redfishwrapper.SetBiosConfiguration(ctx, biosConfig)
+// GetPendingBiosConfiguration returns the BIOS configuration changes staged to be applied on the next reset
+func (c *Conn) GetPendingBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
+	return c.redfishwrapper.GetPendingBiosConfiguration(ctx)
+}
+
+// BiosAttributeRegistry returns the BIOS attribute registry
+func (c *Conn) BiosAttributeRegistry(ctx context.Context) (registry bmc.BiosAttributeRegistry, err error) {
+	return c.redfishwrapper.BiosAttributeRegistry(ctx)
+}
+
+// GetSecureBoot returns the UEFI Secure Boot state
+func (c *Conn) GetSecureBoot(ctx context.Context) (secureBoot bmc.SecureBoot, err error) {
+	return c.redfishwrapper.GetSecureBoot(ctx)
+}
+
+// SetSecureBoot enables or disables UEFI Secure Boot from the next boot
+func (c *Conn) SetSecureBoot(ctx context.Context, enabled bool) (err error) {
+	return c.redfishwrapper.SetSecureBoot(ctx, enabled)
+}
+
+// SetSecureBootMode sets the UEFI Secure Boot mode, Setup or User mode
+func (c *Conn) SetSecureBootMode(ctx context.Context, mode string) (err error) {
+	return c.redfishwrapper.SetSecureBootMode(ctx, mode)
+}
+
+// ResetSecureBootKeys resets the UEFI Secure Boot keys to the defaults
+func (c *Conn) ResetSecureBootKeys(ctx context.Context) (err error) {
+	return c.redfishwrapper.ResetSecureBootKeys(ctx)
+}
+
+// DeleteSecureBootKeys deletes all UEFI Secure Boot keys
+func (c *Conn) DeleteSecureBootKeys(ctx context.Context) (err error) {
+	return c.redfishwrapper.DeleteSecureBootKeys(ctx)
+}
+
+// SecureBootCertificates returns the certificates in the UEFI Secure Boot key database
+func (c *Conn) SecureBootCertificates(ctx context.Context, database string) (certificates []bmc.SecureBootCertificate, err error) {
+	return c.redfishwrapper.SecureBootCertificates(ctx, database)
+}
+
+// ImportSecureBootCertificate enrolls the PEM certificate in the UEFI Secure Boot key database
+func (c *Conn) ImportSecureBootCertificate(ctx context.Context, database, certificate string) (err error) {
+	return c.redfishwrapper.ImportSecureBootCertificate(ctx, database, certificate)
 }
 
 // ResetBiosConfiguration resets the BIOS configuration settings back to 'factory defaults' via the BMC
-func (c *Conn) ResetBiosConfiguration(ctx context.Context) (err error) {
-	return c.redfishwrapper.ResetBiosConfiguration(ctx)
+func (c *Conn) ResetBiosConfiguration(ctx context.Context, applyTime bmc.BiosApplyTime) (err error) {
+	return c.redfishwrapper.ResetBiosConfiguration(ctx, applyTime)
 }
 
 // SendNMI tells the BMC to issue an NMI to the device
@@ -0,0 +1,263 @@
+package dell
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	importSystemConfigurationEndpoint        = "/Managers/iDRAC.Embedded.1/Actions/Oem/EID_674_Manager.ImportSystemConfiguration"
+	importSystemConfigurationPreviewEndpoint = "/Managers/iDRAC.Embedded.1/Actions/Oem/EID_674_Manager.ImportSystemConfigurationPreview"
+	exportSystemConfigurationEndpoint        = "/Managers/iDRAC.Embedded.1/Actions/Oem/EID_674_Manager.ExportSystemConfiguration"
+
+	// scpTargetAll includes all components in the Server Configuration Profile
+	scpTargetAll = "ALL"
+	// scpTargetBIOS limits the Server Configuration Profile to the BIOS component
+	scpTargetBIOS = "BIOS"
+)
+
+// scpExportPollInterval is the interval the export task is polled at for the exported profile.
+var scpExportPollInterval = 5 * time.Second
+
+// scpShareParameters selects the components included in the Server Configuration Profile,
+// multiple components are listed comma separated.
+type scpShareParameters struct {
+	Target string `json:"Target"`
+}
+
+// scpImport is the ImportSystemConfiguration action request with the profile passed inline,
+// the preview action does not take a shutdown type.
+type scpImport struct {
+	ImportBuffer    string             `json:"ImportBuffer"`
+	ShareParameters scpShareParameters `json:"ShareParameters"`
+	ShutdownType    string             `json:"ShutdownType,omitempty"`
+}
+
+// scpExport is the ExportSystemConfiguration action request with the profile returned inline.
+type scpExport struct {
+	ExportFormat    string             `json:"ExportFormat"`
+	ShareParameters scpShareParameters `json:"ShareParameters"`
+}
+
+// scpTarget returns the Server Configuration Profile target for the components.
+func scpTarget(targets []string) string {
+	if len(targets) == 0 {
+		return scpTargetAll
+	}
+
+	return strings.Join(targets, ",")
+}
+
+// isServerConfigurationProfile returns true when the cfg is a Dell Server Configuration Profile in XML or JSON.
+func isServerConfigurationProfile(cfg string) bool {
+	cfg = strings.TrimSpace(cfg)
+
+	if strings.HasPrefix(cfg, "<") {
+		return strings.Contains(cfg, "<SystemConfiguration")
+	}
+
+	profile := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(cfg), &profile); err != nil {
+		return false
+	}
+
+	_, exists := profile["SystemConfiguration"]
+
+	return exists
+}
+
+// ExportSystemConfiguration returns the Server Configuration Profile of the target components,
+// the profile of all components is returned when no targets are given.
+func (c *Conn) ExportSystemConfiguration(ctx context.Context, opts bmc.SystemConfigurationExportOptions) (cfg string, err error) {
+	format := opts.Format
+	if format == "" {
+		format = bmc.SystemConfigurationXML
+	}
+
+	return c.exportSystemConfiguration(ctx, format, scpTarget(opts.Targets))
+}
+
+// ImportSystemConfiguration imports the Server Configuration Profile and returns the import job ID,
+// the job status is returned by SystemConfigurationJobStatus.
+//
+// The profile is applied on the next host reboot unless a shutdown type is given,
+// with Preview the profile is checked against the system without being applied.
+func (c *Conn) ImportSystemConfiguration(ctx context.Context, cfg string, opts bmc.SystemConfigurationImportOptions) (jobID string, err error) {
+	if !isServerConfigurationProfile(cfg) {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "not a server configuration profile")
+	}
+
+	return c.importSystemConfiguration(ctx, cfg, opts)
+}
+
+// SystemConfigurationJobStatus returns the status of a Server Configuration Profile import job.
+func (c *Conn) SystemConfigurationJobStatus(ctx context.Context, jobID string) (state constants.TaskState, status string, err error) {
+	return c.statusFromJob(jobID)
+}
+
+// SetBiosConfigurationFromFile sets the BIOS configuration from a Dell Server Configuration Profile in XML or JSON,
+// or a vendor neutral YAML or JSON profile. The changes are applied on the next host reboot.
+func (c *Conn) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
+	if !isServerConfigurationProfile(cfg) {
+		biosConfig, err := bmc.UnmarshalBiosProfile(cfg)
+		if err != nil {
+			return err
+		}
+
+		return c.SetBiosConfiguration(ctx, biosConfig, bmc.BiosApplyTime{})
+	}
+
+	jobID, err := c.importSystemConfiguration(ctx, cfg, bmc.SystemConfigurationImportOptions{Targets: []string{scpTargetBIOS}})
+	if err != nil {
+		return err
+	}
+
+	c.Log.V(2).Info("BIOS configuration import job created", "jobID", jobID)
+
+	return nil
+}
+
+// ExportBiosConfigurationToFile returns the BIOS configuration as a Dell Server Configuration Profile in XML or JSON,
+// or as a vendor neutral YAML or JSON profile, either is accepted as is by SetBiosConfigurationFromFile.
+func (c *Conn) ExportBiosConfigurationToFile(ctx context.Context, format bmc.BiosConfigurationFormat) (cfg string, err error) {
+	switch format {
+	case bmc.BiosConfigurationSCPXML:
+		return c.exportSystemConfiguration(ctx, bmc.SystemConfigurationXML, scpTargetBIOS)
+	case bmc.BiosConfigurationSCPJSON:
+		return c.exportSystemConfiguration(ctx, bmc.SystemConfigurationJSON, scpTargetBIOS)
+	default:
+		return c.redfishwrapper.ExportBiosConfigurationToFile(ctx, format)
+	}
+}
+
+// importSystemConfiguration imports the Server Configuration Profile, or previews the import, and returns the job ID.
+func (c *Conn) importSystemConfiguration(ctx context.Context, scp string, opts bmc.SystemConfigurationImportOptions) (jobID string, err error) {
+	request := scpImport{
+		ImportBuffer:    scp,
+		ShareParameters: scpShareParameters{Target: scpTarget(opts.Targets)},
+	}
+
+	endpoint := importSystemConfigurationPreviewEndpoint
+	if !opts.Preview {
+		endpoint = importSystemConfigurationEndpoint
+
+		request.ShutdownType = string(opts.ShutdownType)
+		if request.ShutdownType == "" {
+			request.ShutdownType = string(bmc.SystemConfigurationNoReboot)
+		}
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "import: "+err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PostWithHeaders(
+		ctx,
+		redfishV1Prefix+endpoint,
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "import: "+err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "import: unexpected status code: "+resp.Status)
+	}
+
+	// the job is referenced by the Location header, /redfish/v1/TaskService/Tasks/JID_<id>
+	return path.Base(resp.Header.Get("Location")), nil
+}
+
+// exportSystemConfiguration exports the Server Configuration Profile of the target components in the XML or JSON format,
+// the export task is polled until it returns the profile.
+func (c *Conn) exportSystemConfiguration(ctx context.Context, format bmc.SystemConfigurationFormat, target string) (scp string, err error) {
+	payload, err := json.Marshal(scpExport{
+		ExportFormat:    string(format),
+		ShareParameters: scpShareParameters{Target: target},
+	})
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: "+err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PostWithHeaders(
+		ctx,
+		redfishV1Prefix+exportSystemConfigurationEndpoint,
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: "+err.Error())
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: unexpected status code: "+resp.Status)
+	}
+
+	taskURI := resp.Header.Get("Location")
+	if taskURI == "" {
+		return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: no export task returned")
+	}
+
+	for {
+		// the task is returned with a 202 while the export runs, the profile is returned with a 200 once done
+		done, scp, err := c.exportTaskResult(taskURI)
+		if err != nil {
+			return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: "+err.Error())
+		}
+
+		if done {
+			return scp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", errors.Wrap(bmclibErrs.ErrSystemConfiguration, "export: "+ctx.Err().Error())
+		case <-time.After(scpExportPollInterval):
+		}
+	}
+}
+
+// exportTaskResult returns the exported profile when the export task is done.
+func (c *Conn) exportTaskResult(taskURI string) (done bool, scp string, err error) {
+	resp, err := c.redfishwrapper.Get(taskURI)
+	if err != nil {
+		return false, "", err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, "", err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return false, "", nil
+	case http.StatusOK:
+		if !isServerConfigurationProfile(string(body)) {
+			return false, "", errors.New("export task did not return a server configuration profile")
+		}
+
+		return true, string(bytes.TrimSpace(body)), nil
+	default:
+		return false, "", errors.New("unexpected status code: " + resp.Status)
+	}
+}
@@ -0,0 +1,326 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func scpClient(t *testing.T, handlers map[string]http.HandlerFunc) (*Conn, func()) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redfish/v1/", endpointFunc("/serviceroot.json"))
+	mux.HandleFunc("/redfish/v1/Systems", endpointFunc("/systems.json"))
+	mux.HandleFunc("/redfish/v1/Systems/System.Embedded.1", endpointFunc("/systems_embedded.1.json"))
+
+	for endpoint, handler := range handlers {
+		mux.HandleFunc(endpoint, handler)
+	}
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := New(parsedURL.Hostname(), "", "", logr.Discard(), WithPort(parsedURL.Port()), WithUseBasicAuth(true))
+	if err := client.Open(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client, server.Close
+}
+
+func TestIsServerConfigurationProfile(t *testing.T) {
+	scp, err := os.ReadFile(fixturesDir + "/scp_bios.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      string
+		expected bool
+	}{
+		{"scp xml", string(scp), true},
+		{"scp json", `{"SystemConfiguration": {"Components": []}}`, true},
+		{"profile yaml", "attributes:\n  boot_mode: UEFI\n", false},
+		{"profile json", `{"attributes": {"boot_mode": "UEFI"}}`, false},
+		{"sum xml", "<BiosCfg></BiosCfg>", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isServerConfigurationProfile(tc.cfg))
+		})
+	}
+}
+
+func TestSetBiosConfigurationFromFile(t *testing.T) {
+	scp, err := os.ReadFile(fixturesDir + "/scp_bios.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name           string
+		cfg            string
+		expectedImport *scpImport
+		expectedStaged map[string]interface{}
+	}{
+		{
+			"server configuration profile",
+			string(scp),
+			&scpImport{
+				ImportBuffer:    string(scp),
+				ShareParameters: scpShareParameters{Target: "BIOS"},
+				ShutdownType:    "NoReboot",
+			},
+			nil,
+		},
+		{
+			"vendor neutral profile",
+			"attributes:\n  boot_mode: UEFI\n  LogicalProc: Enabled\n",
+			nil,
+			// LogicalProc is unchanged
+			map[string]interface{}{"Attributes": map[string]interface{}{"BootMode": "Uefi"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var imported *scpImport
+			var staged map[string]interface{}
+
+			client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+				"/redfish/v1/Systems/System.Embedded.1/Bios": endpointFunc("/bios.json"),
+				"/redfish/v1/Systems/System.Embedded.1/Bios/Settings": func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != http.MethodPatch {
+						endpointFunc("/bios_settings.json")(w, r)
+						return
+					}
+
+					if err := json.NewDecoder(r.Body).Decode(&staged); err != nil {
+						t.Fatal(err)
+					}
+				},
+				redfishV1Prefix + jobsEndpoint: func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Location", redfishV1Prefix+jobsEndpoint+"/JID_123")
+					w.WriteHeader(http.StatusOK)
+				},
+				redfishV1Prefix + importSystemConfigurationEndpoint: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+
+					imported = &scpImport{}
+					if err := json.NewDecoder(r.Body).Decode(imported); err != nil {
+						t.Fatal(err)
+					}
+
+					w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/JID_456")
+					w.WriteHeader(http.StatusAccepted)
+				},
+			})
+			defer closeFn()
+
+			assert.Nil(t, client.SetBiosConfigurationFromFile(context.TODO(), tc.cfg))
+			assert.Equal(t, tc.expectedImport, imported)
+			assert.Equal(t, tc.expectedStaged, staged)
+		})
+	}
+}
+
+func TestExportBiosConfigurationToFile(t *testing.T) {
+	scp, err := os.ReadFile(fixturesDir + "/scp_bios.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interval := scpExportPollInterval
+	scpExportPollInterval = time.Millisecond
+
+	defer func() { scpExportPollInterval = interval }()
+
+	var exported *scpExport
+	polls := 0
+
+	client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+		redfishV1Prefix + exportSystemConfigurationEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			exported = &scpExport{}
+			if err := json.NewDecoder(r.Body).Decode(exported); err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/JID_789")
+			w.WriteHeader(http.StatusAccepted)
+		},
+		"/redfish/v1/TaskService/Tasks/JID_789": func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			// the task is running on the first poll
+			if polls == 1 {
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"TaskState": "Running"}`))
+
+				return
+			}
+
+			_, _ = w.Write(scp)
+		},
+	})
+	defer closeFn()
+
+	cfg, err := client.ExportBiosConfigurationToFile(context.TODO(), bmc.BiosConfigurationSCPXML)
+	assert.Nil(t, err)
+	assert.Equal(t, &scpExport{ExportFormat: "XML", ShareParameters: scpShareParameters{Target: "BIOS"}}, exported)
+	assert.Equal(t, 2, polls)
+	assert.True(t, isServerConfigurationProfile(cfg))
+	assert.Contains(t, cfg, `<Attribute Name="BootMode">Uefi</Attribute>`)
+}
+
+func TestExportSystemConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     bmc.SystemConfigurationExportOptions
+		expected scpExport
+	}{
+		{"defaults", bmc.SystemConfigurationExportOptions{}, scpExport{ExportFormat: "XML", ShareParameters: scpShareParameters{Target: "ALL"}}},
+		{
+			"targets",
+			bmc.SystemConfigurationExportOptions{Targets: []string{"RAID", "NIC"}, Format: bmc.SystemConfigurationJSON},
+			scpExport{ExportFormat: "JSON", ShareParameters: scpShareParameters{Target: "RAID,NIC"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var exported scpExport
+
+			client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+				redfishV1Prefix + exportSystemConfigurationEndpoint: func(w http.ResponseWriter, r *http.Request) {
+					if err := json.NewDecoder(r.Body).Decode(&exported); err != nil {
+						t.Fatal(err)
+					}
+
+					w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/JID_789")
+					w.WriteHeader(http.StatusAccepted)
+				},
+				"/redfish/v1/TaskService/Tasks/JID_789": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(`{"SystemConfiguration": {"Components": []}}`))
+				},
+			})
+			defer closeFn()
+
+			cfg, err := client.ExportSystemConfiguration(context.TODO(), tc.opts)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, exported)
+			assert.Equal(t, `{"SystemConfiguration": {"Components": []}}`, cfg)
+		})
+	}
+}
+
+func TestImportSystemConfiguration(t *testing.T) {
+	scp, err := os.ReadFile(fixturesDir + "/scp_bios.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name             string
+		cfg              string
+		opts             bmc.SystemConfigurationImportOptions
+		expectedEndpoint string
+		expectedImport   *scpImport
+		err              error
+	}{
+		{
+			"defaults",
+			string(scp),
+			bmc.SystemConfigurationImportOptions{},
+			importSystemConfigurationEndpoint,
+			&scpImport{ImportBuffer: string(scp), ShareParameters: scpShareParameters{Target: "ALL"}, ShutdownType: "NoReboot"},
+			nil,
+		},
+		{
+			"graceful shutdown",
+			string(scp),
+			bmc.SystemConfigurationImportOptions{Targets: []string{"BIOS", "RAID"}, ShutdownType: bmc.SystemConfigurationGraceful},
+			importSystemConfigurationEndpoint,
+			&scpImport{ImportBuffer: string(scp), ShareParameters: scpShareParameters{Target: "BIOS,RAID"}, ShutdownType: "Graceful"},
+			nil,
+		},
+		{
+			"preview",
+			string(scp),
+			bmc.SystemConfigurationImportOptions{Preview: true, ShutdownType: bmc.SystemConfigurationForced},
+			importSystemConfigurationPreviewEndpoint,
+			&scpImport{ImportBuffer: string(scp), ShareParameters: scpShareParameters{Target: "ALL"}},
+			nil,
+		},
+		{
+			"not a server configuration profile",
+			"attributes:\n  boot_mode: UEFI\n",
+			bmc.SystemConfigurationImportOptions{},
+			"",
+			nil,
+			bmclibErrs.ErrSystemConfiguration,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var endpoint string
+			var imported *scpImport
+
+			importHandler := func(w http.ResponseWriter, r *http.Request) {
+				endpoint = strings.TrimPrefix(r.URL.Path, redfishV1Prefix)
+
+				imported = &scpImport{}
+				if err := json.NewDecoder(r.Body).Decode(imported); err != nil {
+					t.Fatal(err)
+				}
+
+				w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/JID_456")
+				w.WriteHeader(http.StatusAccepted)
+			}
+
+			client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+				redfishV1Prefix + importSystemConfigurationEndpoint:        importHandler,
+				redfishV1Prefix + importSystemConfigurationPreviewEndpoint: importHandler,
+				"/redfish/v1/Managers/iDRAC.Embedded.1/Oem/Dell/Jobs/JID_456": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(`{"Id": "JID_456", "JobState": "Completed", "Message": "Successfully imported and applied Server Configuration Profile.", "PercentComplete": 100}`))
+				},
+			})
+			defer closeFn()
+
+			jobID, err := client.ImportSystemConfiguration(context.TODO(), tc.cfg, tc.opts)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Nil(t, imported)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "JID_456", jobID)
+			assert.Equal(t, tc.expectedEndpoint, endpoint)
+			assert.Equal(t, tc.expectedImport, imported)
+
+			state, status, err := client.SystemConfigurationJobStatus(context.TODO(), jobID)
+			assert.Nil(t, err)
+			assert.Equal(t, constants.Complete, state)
+			assert.Contains(t, status, "Successfully imported")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+const systemEraseEndpoint = "/Dell/Managers/iDRAC.Embedded.1/DellLCService/Actions/DellLCService.SystemErase"
+
+// systemErase is the SystemErase action request, the components are erased by a job that powers off the host.
+type systemErase struct {
+	Component []string `json:"Component"`
+}
+
+// SecureErase securely erases the drives and runs the Lifecycle Controller system erase of the system components,
+// for example BIOS, IDRAC, LCData, DrvPack, OverwritePD or CryptographicErasePD.
+//
+// The job IDs are returned in the order of the drives, followed by the system erase job.
+func (c *Conn) SecureErase(ctx context.Context, targets []string) (jobIDs []string, err error) {
+	drives, systemComponents := bmc.SplitEraseTargets(targets)
+
+	if len(drives) > 0 {
+		jobIDs, err = c.redfishwrapper.SecureEraseDrives(ctx, drives)
+		if err != nil {
+			return jobIDs, err
+		}
+	}
+
+	if len(systemComponents) == 0 {
+		return jobIDs, nil
+	}
+
+	jobID, err := c.systemErase(ctx, systemComponents)
+	if err != nil {
+		return jobIDs, err
+	}
+
+	return append(jobIDs, jobID), nil
+}
+
+// EraseTaskStatus returns the status of the erase job.
+func (c *Conn) EraseTaskStatus(ctx context.Context, jobID string) (state constants.TaskState, status string, err error) {
+	return c.statusFromJob(jobID)
+}
+
+// systemErase runs the Lifecycle Controller system erase of the components and returns the job ID.
+func (c *Conn) systemErase(ctx context.Context, components []string) (jobID string, err error) {
+	payload, err := json.Marshal(systemErase{Component: components})
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSecureErase, "system erase: "+err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PostWithHeaders(
+		ctx,
+		redfishV1Prefix+systemEraseEndpoint,
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrSecureErase, "system erase: "+err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", errors.Wrap(bmclibErrs.ErrSecureErase, "system erase: unexpected status code: "+resp.Status)
+	}
+
+	// the job is referenced by the Location header, /redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_<id>
+	return path.Base(resp.Header.Get("Location")), nil
+}
@@ -0,0 +1,32 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecureEraseSystem(t *testing.T) {
+	var erase *systemErase
+
+	client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+		redfishV1Prefix + systemEraseEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			erase = &systemErase{}
+			if err := json.NewDecoder(r.Body).Decode(erase); err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Set("Location", redfishV1Prefix+jobsEndpoint+"/JID_123")
+			w.WriteHeader(http.StatusAccepted)
+		},
+	})
+	defer closeFn()
+
+	jobIDs, err := client.SecureErase(context.TODO(), []string{"system:BIOS", "system:OverwritePD"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"JID_123"}, jobIDs)
+	assert.Equal(t, &systemErase{Component: []string{"BIOS", "OverwritePD"}}, erase)
+}
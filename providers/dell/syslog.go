@@ -0,0 +1,85 @@
+package dell
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+const (
+	// iDRAC manager attributes for remote syslog
+	syslogEnable = "SysLog.1.SysLogEnable"
+	syslogPort   = "SysLog.1.Port"
+
+	// syslogServers is the number of remote syslog servers supported by the iDRAC.
+	syslogServers = 3
+)
+
+func syslogServerAttribute(n int) string {
+	return fmt.Sprintf("SysLog.1.Server%d", n)
+}
+
+// GetRemoteSyslog returns the iDRAC remote syslog configuration.
+func (c *Conn) GetRemoteSyslog(_ context.Context) (syslog bmc.RemoteSyslog, err error) {
+	attributes, err := c.managerAttributes()
+	if err != nil {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	enable, exists := attributes[syslogEnable]
+	if !exists {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, "no remote syslog attributes found")
+	}
+
+	syslog.Enabled = enable == attributeEnabled
+
+	// numeric attributes are decoded as float64
+	if port, ok := attributes[syslogPort].(float64); ok {
+		syslog.Port = int(port)
+	}
+
+	for n := 1; n <= syslogServers; n++ {
+		if server, _ := attributes[syslogServerAttribute(n)].(string); server != "" {
+			syslog.Servers = append(syslog.Servers, server)
+		}
+	}
+
+	return syslog, nil
+}
+
+// SetRemoteSyslog sets the iDRAC remote syslog configuration, the unused syslog server attributes are cleared.
+func (c *Conn) SetRemoteSyslog(ctx context.Context, syslog bmc.RemoteSyslog) (err error) {
+	if len(syslog.Servers) > syslogServers {
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, fmt.Sprintf("at most %d syslog servers are supported", syslogServers))
+	}
+
+	state := attributeDisabled
+	if syslog.Enabled {
+		state = attributeEnabled
+	}
+
+	update := map[string]interface{}{syslogEnable: state}
+
+	for n := 1; n <= syslogServers; n++ {
+		server := ""
+		if n <= len(syslog.Servers) {
+			server = syslog.Servers[n-1]
+		}
+
+		update[syslogServerAttribute(n)] = server
+	}
+
+	if syslog.Port != 0 {
+		update[syslogPort] = syslog.Port
+	}
+
+	if err := c.setManagerAttributes(ctx, update); err != nil {
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package dell
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+func TestGetRemoteSyslog(t *testing.T) {
+	client, closeFn := managerAttributesClient(t, &map[string]interface{}{})
+	defer closeFn()
+
+	syslog, err := client.GetRemoteSyslog(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.50"}, Port: 514}, syslog)
+}
+
+func TestSetRemoteSyslog(t *testing.T) {
+	tests := map[string]struct {
+		syslog bmc.RemoteSyslog
+		expect map[string]interface{}
+		err    error
+	}{
+		"enable": {
+			syslog: bmc.RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.51", "192.0.2.52"}, Port: 1514},
+			expect: map[string]interface{}{
+				"SysLog.1.SysLogEnable": "Enabled",
+				"SysLog.1.Server1":      "192.0.2.51",
+				"SysLog.1.Server2":      "192.0.2.52",
+				"SysLog.1.Server3":      "",
+				"SysLog.1.Port":         float64(1514),
+			},
+		},
+		"disable": {
+			syslog: bmc.RemoteSyslog{},
+			expect: map[string]interface{}{
+				"SysLog.1.SysLogEnable": "Disabled",
+				"SysLog.1.Server1":      "",
+				"SysLog.1.Server2":      "",
+				"SysLog.1.Server3":      "",
+			},
+		},
+		"too many servers": {
+			syslog: bmc.RemoteSyslog{Enabled: true, Servers: []string{"a", "b", "c", "d"}},
+			err:    bmclibErrs.ErrRemoteSyslog,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]interface{}{}
+
+			client, closeFn := managerAttributesClient(t, &patched)
+			defer closeFn()
+
+			err := client.SetRemoteSyslog(context.TODO(), tc.syslog)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package dell
+
+import (
+	"context"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+)
+
+// GetTPM returns the TPM state
+func (c *Conn) GetTPM(ctx context.Context) (tpm bmc.TPM, err error) {
+	return c.redfishwrapper.GetTPM(ctx)
+}
+
+// SetTPM enables or disables the TPM through the TpmSecurity BIOS attribute,
+// applied by a configuration job on the next host reboot.
+func (c *Conn) SetTPM(ctx context.Context, enabled bool) (err error) {
+	return c.SetBiosConfiguration(ctx, redfishwrapper.TPMBiosConfiguration(enabled), bmc.BiosApplyTime{})
+}
+
+// ClearTPM clears the TPM through the Tpm2Hierarchy or TpmCommand BIOS attribute, with the physical presence
+// prompt bypassed, applied by a configuration job on the next host reboot.
+func (c *Conn) ClearTPM(ctx context.Context) (err error) {
+	biosConfig, err := c.redfishwrapper.TPMClearBiosConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.SetBiosConfiguration(ctx, biosConfig, bmc.BiosApplyTime{})
+}
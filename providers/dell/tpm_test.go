@@ -0,0 +1,46 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClearTPM(t *testing.T) {
+	var staged map[string]interface{}
+	var job *configJob
+
+	client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+		"/redfish/v1/Systems/System.Embedded.1/Bios": endpointFunc("/bios.json"),
+		"/redfish/v1/Systems/System.Embedded.1/Bios/Settings": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				endpointFunc("/bios_settings.json")(w, r)
+				return
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&staged); err != nil {
+				t.Fatal(err)
+			}
+		},
+		redfishV1Prefix + jobsEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			job = &configJob{}
+			if err := json.NewDecoder(r.Body).Decode(job); err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Set("Location", redfishV1Prefix+jobsEndpoint+"/JID_123")
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	defer closeFn()
+
+	assert.Nil(t, client.ClearTPM(context.TODO()))
+	assert.Equal(t,
+		map[string]interface{}{"Attributes": map[string]interface{}{"Tpm2Hierarchy": "Clear", "TpmPpiBypassClear": "Enabled"}},
+		staged,
+	)
+	assert.Equal(t, &configJob{TargetSettingsURI: "/redfish/v1/Systems/System.Embedded.1/Bios/Settings", StartTime: jobStartNow}, job)
+}
@@ -0,0 +1,90 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/pkg/errors"
+)
+
+// storageSettingsEndpoint is the pending settings resource of a storage controller,
+// the controller ID is appended to the storage endpoint.
+const storageSettingsEndpoint = "/Systems/System.Embedded.1/Storage/%s/Settings"
+
+// storageControllerMode is the PERC controller mode change, applied by a job on the next host reboot.
+type storageControllerMode struct {
+	Oem struct {
+		Dell struct {
+			DellStorageController struct {
+				ControllerMode string `json:"ControllerMode"`
+			} `json:"DellStorageController"`
+		} `json:"Dell"`
+	} `json:"Oem"`
+	ApplyTime struct {
+		ApplyTime string `json:"ApplyTime"`
+	} `json:"@Redfish.SettingsApplyTime"`
+}
+
+// Volumes returns the volumes configured on the storage controllers.
+func (c *Conn) Volumes(ctx context.Context) (volumes []bmc.Volume, err error) {
+	return c.redfishwrapper.Volumes(ctx)
+}
+
+// CreateVolume creates the volume and returns the job ID, the volume is created on the next host reboot
+// unless the controller supports applying the change right away and the apply time is Immediate.
+func (c *Conn) CreateVolume(ctx context.Context, spec bmc.VolumeSpec) (jobID string, err error) {
+	return c.redfishwrapper.CreateVolume(ctx, spec)
+}
+
+// DeleteVolume deletes the volume and returns the job ID.
+func (c *Conn) DeleteVolume(ctx context.Context, controller, volumeID string) (jobID string, err error) {
+	return c.redfishwrapper.DeleteVolume(ctx, controller, volumeID)
+}
+
+// InitializeVolume erases the data on the volume and returns the job ID.
+func (c *Conn) InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (jobID string, err error) {
+	return c.redfishwrapper.InitializeVolume(ctx, controller, volumeID, fast)
+}
+
+// SetStorageControllerMode switches the PERC controller between RAID and HBA mode and returns the job ID,
+// the mode is changed on the next host reboot. Volumes on the controller are to be deleted before switching to HBA mode.
+func (c *Conn) SetStorageControllerMode(ctx context.Context, controller string, mode bmc.StorageControllerMode) (jobID string, err error) {
+	request := storageControllerMode{}
+	request.Oem.Dell.DellStorageController.ControllerMode = string(mode)
+	request.ApplyTime.ApplyTime = string(constants.OnReset)
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	resp, err := c.redfishwrapper.PatchWithHeaders(
+		ctx,
+		redfishV1Prefix+fmt.Sprintf(storageSettingsEndpoint, controller),
+		json.RawMessage(payload),
+		map[string]string{"Content-Type": "application/json"},
+	)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, err.Error())
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", errors.Wrap(bmclibErrs.ErrVolume, "unexpected status code: "+resp.Status)
+	}
+
+	// the job is referenced by the Location header, /redfish/v1/Managers/iDRAC.Embedded.1/Jobs/JID_<id>
+	return path.Base(resp.Header.Get("Location")), nil
+}
+
+// VolumeTaskStatus returns the status of the job applying a volume or storage controller change.
+func (c *Conn) VolumeTaskStatus(ctx context.Context, jobID string) (state constants.TaskState, status string, err error) {
+	return c.statusFromJob(jobID)
+}
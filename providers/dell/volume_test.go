@@ -0,0 +1,43 @@
+package dell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetStorageControllerMode(t *testing.T) {
+	var patched map[string]interface{}
+
+	client, closeFn := scpClient(t, map[string]http.HandlerFunc{
+		"/redfish/v1/Systems/System.Embedded.1/Storage/RAID.Integrated.1-1/Settings": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Set("Location", redfishV1Prefix+jobsEndpoint+"/JID_123")
+			w.WriteHeader(http.StatusAccepted)
+		},
+	})
+	defer closeFn()
+
+	jobID, err := client.SetStorageControllerMode(context.TODO(), "RAID.Integrated.1-1", bmc.StorageControllerHBA)
+	assert.Nil(t, err)
+	assert.Equal(t, "JID_123", jobID)
+	assert.Equal(t,
+		map[string]interface{}{
+			"Oem":                        map[string]interface{}{"Dell": map[string]interface{}{"DellStorageController": map[string]interface{}{"ControllerMode": "HBA"}}},
+			"@Redfish.SettingsApplyTime": map[string]interface{}{"ApplyTime": "OnReset"},
+		},
+		patched,
+	)
+}
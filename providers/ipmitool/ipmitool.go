@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/bmc-toolbox/bmclib/v2/internal/ipmi"
 	"github.com/bmc-toolbox/bmclib/v2/providers"
@@ -25,12 +27,17 @@ var (
 		providers.FeaturePowerSet,
 		providers.FeaturePowerState,
 		providers.FeatureUserRead,
+		providers.FeatureUserCreate,
+		providers.FeatureUserUpdate,
+		providers.FeatureUserDelete,
 		providers.FeatureBmcReset,
 		providers.FeatureBootDeviceSet,
 		providers.FeatureClearSystemEventLog,
 		providers.FeatureGetSystemEventLog,
 		providers.FeatureGetSystemEventLogRaw,
 		providers.FeatureDeactivateSOL,
+		providers.FeatureAlertDestinations,
+		providers.FeatureBMCTime,
 	}
 )
 
@@ -160,6 +167,38 @@ func (c *Conn) UserRead(ctx context.Context) (users []map[string]string, err err
 	return c.ipmitool.ReadUsers(ctx)
 }
 
+// Users returns the user accounts
+func (c *Conn) Users(ctx context.Context) (users []bmc.User, err error) {
+	return c.ipmitool.Users(ctx)
+}
+
+// UserCreate creates a user account
+func (c *Conn) UserCreate(ctx context.Context, user, pass, role string) (ok bool, err error) {
+	if err := c.ipmitool.CreateUser(ctx, user, pass, role); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UserUpdate updates the user account password and role, the password is left unchanged when empty
+func (c *Conn) UserUpdate(ctx context.Context, user, pass, role string) (ok bool, err error) {
+	if err := c.ipmitool.UpdateUser(ctx, user, pass, role); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UserDelete deletes a user account
+func (c *Conn) UserDelete(ctx context.Context, user string) (ok bool, err error) {
+	if err := c.ipmitool.DeleteUser(ctx, user); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // PowerStateGet gets the power state of a BMC machine
 func (c *Conn) PowerStateGet(ctx context.Context) (state string, err error) {
 	return c.ipmitool.PowerState(ctx)
@@ -202,6 +241,47 @@ func (c *Conn) GetSystemEventLogRaw(ctx context.Context) (eventlog string, err e
 	return c.ipmitool.GetSystemEventLogRaw(ctx)
 }
 
+// AlertDestinations returns the LAN alert destinations
+func (c *Conn) AlertDestinations(ctx context.Context) (destinations []bmc.AlertDestination, err error) {
+	return c.ipmitool.AlertDestinations(ctx)
+}
+
+// SetAlertDestination sets a LAN alert destination
+func (c *Conn) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) (err error) {
+	return c.ipmitool.SetAlertDestination(ctx, destination)
+}
+
+// AlertFilters returns the PEF event filters
+func (c *Conn) AlertFilters(ctx context.Context) (filters []bmc.AlertFilter, err error) {
+	return c.ipmitool.AlertFilters(ctx)
+}
+
+// SetAlertFilter enables or disables a PEF event filter
+func (c *Conn) SetAlertFilter(ctx context.Context, filter bmc.AlertFilter) (err error) {
+	return c.ipmitool.SetAlertFilter(ctx, filter)
+}
+
+// GetBMCTime is not implemented, IPMI reports the SEL clock but has no time zone or NTP configuration,
+// returning the clock alone would report NTP as disabled and hide the providers that read the full configuration.
+func (c *Conn) GetBMCTime(_ context.Context) (bmcTime bmc.BMCTime, err error) {
+	return bmcTime, bmclibErrs.ErrNotImplemented
+}
+
+// SetBMCTime sets the BMC SEL clock time
+func (c *Conn) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	return c.ipmitool.SetSELTime(ctx, dateTime)
+}
+
+// SetBMCTimeZone is not implemented, IPMI has no time zone configuration
+func (c *Conn) SetBMCTimeZone(_ context.Context, _ string) (err error) {
+	return bmclibErrs.ErrNotImplemented
+}
+
+// SetNTPServers is not implemented, IPMI has no NTP configuration
+func (c *Conn) SetNTPServers(_ context.Context, _ bool, _ []string) (err error) {
+	return bmclibErrs.ErrNotImplemented
+}
+
 // SendNMI tells the BMC to issue an NMI to the device
 func (c *Conn) SendNMI(ctx context.Context) error {
 	return c.ipmitool.SendPowerDiag(ctx)
@@ -3,7 +3,7 @@ package openbmc
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
 	"strings"
 	"time"
 
@@ -39,7 +39,7 @@ func (c *Conn) FirmwareInstallSteps(ctx context.Context, component string) ([]co
 	}
 }
 
-func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
 	if err := c.deviceSupported(ctx); err != nil {
 		return "", errNotOpenBMCDevice
 	}
@@ -67,7 +67,7 @@ func (c *Conn) FirmwareInstallUploadAndInitiate(ctx context.Context, component s
 		Oem:                []byte(`{}`),
 	}
 
-	return c.redfishwrapper.FirmwareUpload(ctx, file, params)
+	return c.redfishwrapper.FirmwareUpload(ctx, reader, size, params)
 }
 
 // returns an error when a bmc firmware install is active
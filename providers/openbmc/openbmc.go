@@ -7,7 +7,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
 	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
 	"github.com/bmc-toolbox/bmclib/v2/providers"
@@ -34,6 +36,11 @@ var (
 		providers.FeatureFirmwareUploadInitiateInstall,
 		providers.FeatureFirmwareTaskStatus,
 		providers.FeatureInventoryRead,
+		providers.FeatureBootProgress,
+		providers.FeatureEventSubscription,
+		providers.FeatureBMCTime,
+		providers.FeatureDirectoryService,
+		providers.FeatureAccountPolicy,
 	}
 
 	errNotOpenBMCDevice = errors.New("not an OpenBMC device")
@@ -180,6 +187,66 @@ func (c *Conn) Inventory(ctx context.Context) (device *common.Device, err error)
 	return c.redfishwrapper.Inventory(ctx, false)
 }
 
+// GetBootProgress returns the host boot progress
+func (c *Conn) GetBootProgress(ctx context.Context) (bmc.BootProgress, error) {
+	return c.redfishwrapper.SystemBootProgress(ctx)
+}
+
+// CreateEventSubscription creates a BMC event subscription
+func (c *Conn) CreateEventSubscription(ctx context.Context, subscription bmc.EventSubscription) (id string, err error) {
+	return c.redfishwrapper.CreateEventSubscription(ctx, subscription)
+}
+
+// EventSubscriptions returns the BMC event subscriptions
+func (c *Conn) EventSubscriptions(ctx context.Context) (subscriptions []bmc.EventSubscription, err error) {
+	return c.redfishwrapper.EventSubscriptions(ctx)
+}
+
+// DeleteEventSubscription deletes a BMC event subscription
+func (c *Conn) DeleteEventSubscription(ctx context.Context, id string) (err error) {
+	return c.redfishwrapper.DeleteEventSubscription(ctx, id)
+}
+
+// GetBMCTime returns the BMC time, time zone and NTP configuration
+func (c *Conn) GetBMCTime(ctx context.Context) (bmcTime bmc.BMCTime, err error) {
+	return c.redfishwrapper.GetBMCTime(ctx)
+}
+
+// SetBMCTime sets the BMC date and time
+func (c *Conn) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	return c.redfishwrapper.SetBMCTime(ctx, dateTime)
+}
+
+// SetBMCTimeZone sets the BMC time zone
+func (c *Conn) SetBMCTimeZone(ctx context.Context, timeZone string) (err error) {
+	return c.redfishwrapper.SetBMCTimeZone(ctx, timeZone)
+}
+
+// SetNTPServers sets the BMC NTP configuration
+func (c *Conn) SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error) {
+	return c.redfishwrapper.SetNTPServers(ctx, enabled, servers)
+}
+
+// GetDirectoryService returns the LDAP or Active Directory configuration
+func (c *Conn) GetDirectoryService(ctx context.Context, serviceType bmc.DirectoryServiceType) (service bmc.DirectoryService, err error) {
+	return c.redfishwrapper.GetDirectoryService(ctx, serviceType)
+}
+
+// SetDirectoryService sets the LDAP or Active Directory configuration
+func (c *Conn) SetDirectoryService(ctx context.Context, service bmc.DirectoryService) (err error) {
+	return c.redfishwrapper.SetDirectoryService(ctx, service)
+}
+
+// GetAccountPolicy returns the password, lockout and session timeout policy
+func (c *Conn) GetAccountPolicy(ctx context.Context) (policy bmc.AccountPolicy, err error) {
+	return c.redfishwrapper.GetAccountPolicy(ctx)
+}
+
+// SetAccountPolicy sets the password, lockout and session timeout policy
+func (c *Conn) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) (err error) {
+	return c.redfishwrapper.SetAccountPolicy(ctx, policy)
+}
+
 // BmcReset power cycles the BMC
 func (c *Conn) BmcReset(ctx context.Context, resetType string) (ok bool, err error) {
 	return c.redfishwrapper.BMCReset(ctx, resetType)
@@ -73,9 +73,51 @@ const (
 	// FeatureSetBiosConfigurationFromFile means an implementation that can set bios configuration from a vendor specific text file
 	FeatureSetBiosConfigurationFromFile registrar.Feature = "setbiosconfigfile"
 
+	// FeatureExportBiosConfiguration means an implementation that can export bios configuration to a file accepted by SetBiosConfigurationFromFile
+	FeatureExportBiosConfiguration registrar.Feature = "exportbiosconfig"
+
 	// FeatureGetBiosConfiguration means an implementation that can get bios configuration in a simple k/v map
 	FeatureGetBiosConfiguration registrar.Feature = "getbiosconfig"
 
+	// FeatureBiosAttributeRegistry means an implementation that returns the BIOS attribute registry
+	FeatureBiosAttributeRegistry registrar.Feature = "biosattributeregistry"
+
+	// FeatureExportSystemConfiguration means an implementation that can export a system configuration profile
+	FeatureExportSystemConfiguration registrar.Feature = "exportsystemconfig"
+
+	// FeatureImportSystemConfiguration means an implementation that can import a system configuration profile
+	FeatureImportSystemConfiguration registrar.Feature = "importsystemconfig"
+
+	// FeatureSecureBoot means an implementation that can get and set UEFI Secure Boot and manage the Secure Boot keys
+	FeatureSecureBoot registrar.Feature = "secureboot"
+
+	// FeatureTPM means an implementation that can get the TPM state, enable or disable and clear the TPM
+	FeatureTPM registrar.Feature = "tpm"
+
+	// FeatureVolumes means an implementation that can list, create, delete and initialize storage volumes and set the storage controller mode
+	FeatureVolumes registrar.Feature = "volumes"
+
+	// FeatureSecureErase means an implementation that can securely erase drives and run the whole system erase
+	FeatureSecureErase registrar.Feature = "secureerase"
+
 	// FeatureBootProgress indicates that the implementation supports reading the BootProgress from the BMC
 	FeatureBootProgress registrar.Feature = "bootprogress"
+
+	// FeatureEventSubscription means an implementation that can create, list and delete BMC event subscriptions
+	FeatureEventSubscription registrar.Feature = "eventsubscription"
+
+	// FeatureAlertDestinations means an implementation that can get and set the BMC alert destinations and filters
+	FeatureAlertDestinations registrar.Feature = "alertdestinations"
+
+	// FeatureBMCTime means an implementation that can get and set the BMC time, time zone and NTP servers
+	FeatureBMCTime registrar.Feature = "bmctime"
+
+	// FeatureRemoteSyslog means an implementation that can get and set the BMC remote syslog configuration
+	FeatureRemoteSyslog registrar.Feature = "remotesyslog"
+
+	// FeatureDirectoryService means an implementation that can get and set the BMC LDAP or Active Directory configuration
+	FeatureDirectoryService registrar.Feature = "directoryservice"
+
+	// FeatureAccountPolicy means an implementation that can get and set the BMC password, lockout and session timeout policy
+	FeatureAccountPolicy registrar.Feature = "accountpolicy"
 )
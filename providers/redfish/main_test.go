@@ -21,6 +21,9 @@ var (
 	mockServer  *httptest.Server
 	mockBMCHost *url.URL
 	mockClient  *Conn
+
+	// the body of the last PATCH request to an account
+	accountPatchBody []byte
 )
 
 // jsonResponse returns the fixture json response for a request URI
@@ -35,6 +38,9 @@ func jsonResponse(endpoint string) []byte {
 		"/redfish/v1/Managers/iDRAC.Embedded.1/LogServices/Sel/Entries/2": fixturesDir + "/v1/dell/selentries/2.json",
 
 		"/redfish/v1/":                          fixturesDir + "/v1/serviceroot.json",
+		"/redfish/v1/AccountService":            fixturesDir + "/v1/accountservice.json",
+		"/redfish/v1/AccountService/Accounts":   fixturesDir + "/v1/accounts.json",
+		"/redfish/v1/AccountService/Accounts/3": fixturesDir + "/v1/account.3.json",
 		"/redfish/v1/UpdateService":             fixturesDir + "/v1/updateservice.json",
 		"/redfish/v1/Systems":                   fixturesDir + "/v1/systems.json",
 	}
@@ -60,6 +66,7 @@ func TestMain(m *testing.M) {
 		handler := http.NewServeMux()
 		handler.HandleFunc("/redfish/v1/", serviceRoot)
 		handler.HandleFunc("/redfish/v1/SessionService/Sessions", sessionService)
+		handler.HandleFunc("/redfish/v1/AccountService/Accounts/3", account)
 		return httptest.NewTLSServer(handler)
 	}()
 
@@ -82,6 +89,17 @@ func serviceRoot(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(jsonResponse(r.RequestURI))
 }
 
+func account(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPatch {
+		accountPatchBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	serviceRoot(w, r)
+}
+
 func sessionService(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		w.WriteHeader(http.StatusNotFound)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/x509"
 	"net/http"
+	"time"
 
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
 	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
@@ -13,6 +14,7 @@ import (
 	"github.com/jacobweinstock/registrar"
 
 	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 )
 
@@ -28,6 +30,7 @@ var (
 	Features = registrar.Features{
 		providers.FeaturePowerSet,
 		providers.FeaturePowerState,
+		providers.FeatureUserRead,
 		providers.FeatureUserCreate,
 		providers.FeatureUserUpdate,
 		providers.FeatureUserDelete,
@@ -38,7 +41,19 @@ var (
 		providers.FeatureClearSystemEventLog,
 		providers.FeatureGetBiosConfiguration,
 		providers.FeatureSetBiosConfiguration,
+		providers.FeatureSetBiosConfigurationFromFile,
+		providers.FeatureExportBiosConfiguration,
 		providers.FeatureResetBiosConfiguration,
+		providers.FeatureBiosAttributeRegistry,
+		providers.FeatureSecureBoot,
+		providers.FeatureTPM,
+		providers.FeatureVolumes,
+		providers.FeatureSecureErase,
+		providers.FeatureBootProgress,
+		providers.FeatureEventSubscription,
+		providers.FeatureBMCTime,
+		providers.FeatureDirectoryService,
+		providers.FeatureAccountPolicy,
 	}
 )
 
@@ -190,6 +205,46 @@ func (c *Conn) Compatible(ctx context.Context) bool {
 	return err == nil
 }
 
+// GetBMCTime returns the BMC time, time zone and NTP configuration
+func (c *Conn) GetBMCTime(ctx context.Context) (bmcTime bmc.BMCTime, err error) {
+	return c.redfishwrapper.GetBMCTime(ctx)
+}
+
+// SetBMCTime sets the BMC date and time
+func (c *Conn) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	return c.redfishwrapper.SetBMCTime(ctx, dateTime)
+}
+
+// SetBMCTimeZone sets the BMC time zone
+func (c *Conn) SetBMCTimeZone(ctx context.Context, timeZone string) (err error) {
+	return c.redfishwrapper.SetBMCTimeZone(ctx, timeZone)
+}
+
+// SetNTPServers sets the BMC NTP configuration
+func (c *Conn) SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error) {
+	return c.redfishwrapper.SetNTPServers(ctx, enabled, servers)
+}
+
+// GetDirectoryService returns the LDAP or Active Directory configuration
+func (c *Conn) GetDirectoryService(ctx context.Context, serviceType bmc.DirectoryServiceType) (service bmc.DirectoryService, err error) {
+	return c.redfishwrapper.GetDirectoryService(ctx, serviceType)
+}
+
+// SetDirectoryService sets the LDAP or Active Directory configuration
+func (c *Conn) SetDirectoryService(ctx context.Context, service bmc.DirectoryService) (err error) {
+	return c.redfishwrapper.SetDirectoryService(ctx, service)
+}
+
+// GetAccountPolicy returns the password, lockout and session timeout policy
+func (c *Conn) GetAccountPolicy(ctx context.Context) (policy bmc.AccountPolicy, err error) {
+	return c.redfishwrapper.GetAccountPolicy(ctx)
+}
+
+// SetAccountPolicy sets the password, lockout and session timeout policy
+func (c *Conn) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) (err error) {
+	return c.redfishwrapper.SetAccountPolicy(ctx, policy)
+}
+
 // BmcReset power cycles the BMC
 func (c *Conn) BmcReset(ctx context.Context, resetType string) (ok bool, err error) {
 	return c.redfishwrapper.BMCReset(ctx, resetType)
@@ -225,19 +280,149 @@ func (c *Conn) Inventory(ctx context.Context) (device *common.Device, err error)
 	return c.redfishwrapper.Inventory(ctx, c.failInventoryOnError)
 }
 
+// GetBootProgress returns the host boot progress
+func (c *Conn) GetBootProgress(ctx context.Context) (bmc.BootProgress, error) {
+	return c.redfishwrapper.SystemBootProgress(ctx)
+}
+
+// CreateEventSubscription creates a BMC event subscription
+func (c *Conn) CreateEventSubscription(ctx context.Context, subscription bmc.EventSubscription) (id string, err error) {
+	return c.redfishwrapper.CreateEventSubscription(ctx, subscription)
+}
+
+// EventSubscriptions returns the BMC event subscriptions
+func (c *Conn) EventSubscriptions(ctx context.Context) (subscriptions []bmc.EventSubscription, err error) {
+	return c.redfishwrapper.EventSubscriptions(ctx)
+}
+
+// DeleteEventSubscription deletes a BMC event subscription
+func (c *Conn) DeleteEventSubscription(ctx context.Context, id string) (err error) {
+	return c.redfishwrapper.DeleteEventSubscription(ctx, id)
+}
+
 // GetBiosConfiguration return bios configuration
 func (c *Conn) GetBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
 	return c.redfishwrapper.GetBiosConfiguration(ctx)
 }
 
+// GetPendingBiosConfiguration returns the BIOS configuration changes staged to be applied on the next reset
+func (c *Conn) GetPendingBiosConfiguration(ctx context.Context) (biosConfig map[string]string, err error) {
+	return c.redfishwrapper.GetPendingBiosConfiguration(ctx)
+}
+
+// BiosAttributeRegistry returns the BIOS attribute registry
+func (c *Conn) BiosAttributeRegistry(ctx context.Context) (registry bmc.BiosAttributeRegistry, err error) {
+	return c.redfishwrapper.BiosAttributeRegistry(ctx)
+}
+
 // SetBiosConfiguration set bios configuration
-func (c *Conn) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
-	return c.redfishwrapper.SetBiosConfiguration(ctx, biosConfig)
+func (c *Conn) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime bmc.BiosApplyTime) (err error) {
+	return c.redfishwrapper.SetBiosConfiguration(ctx, biosConfig, applyTime)
+}
+
+// SetBiosConfigurationFromFile sets the bios configuration from a vendor neutral YAML or JSON profile
+func (c *Conn) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
+	return c.redfishwrapper.SetBiosConfigurationFromFile(ctx, cfg)
+}
+
+// ExportBiosConfigurationToFile returns the bios configuration as a vendor neutral YAML or JSON profile
+func (c *Conn) ExportBiosConfigurationToFile(ctx context.Context, format bmc.BiosConfigurationFormat) (cfg string, err error) {
+	return c.redfishwrapper.ExportBiosConfigurationToFile(ctx, format)
+}
+
+// GetSecureBoot returns the UEFI Secure Boot state
+func (c *Conn) GetSecureBoot(ctx context.Context) (secureBoot bmc.SecureBoot, err error) {
+	return c.redfishwrapper.GetSecureBoot(ctx)
+}
+
+// SetSecureBoot enables or disables UEFI Secure Boot from the next boot
+func (c *Conn) SetSecureBoot(ctx context.Context, enabled bool) (err error) {
+	return c.redfishwrapper.SetSecureBoot(ctx, enabled)
+}
+
+// SetSecureBootMode sets the UEFI Secure Boot mode, Setup or User mode
+func (c *Conn) SetSecureBootMode(ctx context.Context, mode string) (err error) {
+	return c.redfishwrapper.SetSecureBootMode(ctx, mode)
+}
+
+// ResetSecureBootKeys resets the UEFI Secure Boot keys to the defaults
+func (c *Conn) ResetSecureBootKeys(ctx context.Context) (err error) {
+	return c.redfishwrapper.ResetSecureBootKeys(ctx)
+}
+
+// DeleteSecureBootKeys deletes all UEFI Secure Boot keys
+func (c *Conn) DeleteSecureBootKeys(ctx context.Context) (err error) {
+	return c.redfishwrapper.DeleteSecureBootKeys(ctx)
+}
+
+// SecureBootCertificates returns the certificates in the UEFI Secure Boot key database
+func (c *Conn) SecureBootCertificates(ctx context.Context, database string) (certificates []bmc.SecureBootCertificate, err error) {
+	return c.redfishwrapper.SecureBootCertificates(ctx, database)
+}
+
+// ImportSecureBootCertificate enrolls the PEM certificate in the UEFI Secure Boot key database
+func (c *Conn) ImportSecureBootCertificate(ctx context.Context, database, certificate string) (err error) {
+	return c.redfishwrapper.ImportSecureBootCertificate(ctx, database, certificate)
+}
+
+// GetTPM returns the TPM state
+func (c *Conn) GetTPM(ctx context.Context) (tpm bmc.TPM, err error) {
+	return c.redfishwrapper.GetTPM(ctx)
+}
+
+// SetTPM enables or disables the TPM on the next reset
+func (c *Conn) SetTPM(ctx context.Context, enabled bool) (err error) {
+	return c.redfishwrapper.SetTPM(ctx, enabled)
+}
+
+// ClearTPM clears the TPM on the next reset
+func (c *Conn) ClearTPM(ctx context.Context) (err error) {
+	return c.redfishwrapper.ClearTPM(ctx)
+}
+
+// Volumes returns the volumes configured on the storage controllers
+func (c *Conn) Volumes(ctx context.Context) (volumes []bmc.Volume, err error) {
+	return c.redfishwrapper.Volumes(ctx)
+}
+
+// CreateVolume creates a volume and returns the task ID when the volume is not created right away
+func (c *Conn) CreateVolume(ctx context.Context, spec bmc.VolumeSpec) (taskID string, err error) {
+	return c.redfishwrapper.CreateVolume(ctx, spec)
+}
+
+// DeleteVolume deletes a volume and returns the task ID when the volume is not deleted right away
+func (c *Conn) DeleteVolume(ctx context.Context, controller, volumeID string) (taskID string, err error) {
+	return c.redfishwrapper.DeleteVolume(ctx, controller, volumeID)
+}
+
+// InitializeVolume erases the data on a volume and returns the task ID when the initialize is not done right away
+func (c *Conn) InitializeVolume(ctx context.Context, controller, volumeID string, fast bool) (taskID string, err error) {
+	return c.redfishwrapper.InitializeVolume(ctx, controller, volumeID, fast)
+}
+
+// SetStorageControllerMode sets the storage controller mode
+func (c *Conn) SetStorageControllerMode(ctx context.Context, controller string, mode bmc.StorageControllerMode) (taskID string, err error) {
+	return c.redfishwrapper.SetStorageControllerMode(ctx, controller, mode)
+}
+
+// VolumeTaskStatus returns the status of the task applying a volume change
+func (c *Conn) VolumeTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	return c.redfishwrapper.VolumeTaskStatus(ctx, taskID)
+}
+
+// SecureErase securely erases the drives and returns the erase task IDs
+func (c *Conn) SecureErase(ctx context.Context, targets []string) (taskIDs []string, err error) {
+	return c.redfishwrapper.SecureErase(ctx, targets)
+}
+
+// EraseTaskStatus returns the status of the erase task
+func (c *Conn) EraseTaskStatus(ctx context.Context, taskID string) (state constants.TaskState, status string, err error) {
+	return c.redfishwrapper.EraseTaskStatus(ctx, taskID)
 }
 
 // ResetBiosConfiguration set bios configuration
-func (c *Conn) ResetBiosConfiguration(ctx context.Context) (err error) {
-	return c.redfishwrapper.ResetBiosConfiguration(ctx)
+func (c *Conn) ResetBiosConfiguration(ctx context.Context, applyTime bmc.BiosApplyTime) (err error) {
+	return c.redfishwrapper.ResetBiosConfiguration(ctx, applyTime)
 }
 
 // SendNMI tells the BMC to issue an NMI to the device
@@ -3,6 +3,7 @@ package redfish
 import (
 	"context"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/internal"
 	"github.com/pkg/errors"
 	"github.com/stmcginnis/gofish/schemas"
@@ -46,7 +47,45 @@ func (c *Conn) UserRead(ctx context.Context) (users []map[string]string, err err
 	return users, nil
 }
 
-// UserUpdate updates a user password and role
+// Users returns the user accounts, including disabled accounts
+func (c *Conn) Users(ctx context.Context) (users []bmc.User, err error) {
+	service, err := c.redfishwrapper.AccountService()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := service.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	users = make([]bmc.User, 0)
+
+	for _, account := range accounts {
+		// unused account slots are listed without a username
+		if account.UserName == "" {
+			continue
+		}
+
+		user := bmc.User{
+			ID:       account.ID,
+			Username: account.UserName,
+			Role:     bmc.NormalizeUserRole(account.RoleID),
+			Enabled:  account.Enabled,
+			Locked:   account.Locked,
+		}
+
+		for _, accountType := range account.AccountTypes {
+			user.AccountTypes = append(user.AccountTypes, string(accountType))
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// UserUpdate updates a user password and role, the password or role are left unchanged when empty
 func (c *Conn) UserUpdate(ctx context.Context, user, pass, role string) (ok bool, err error) {
 	service, err := c.redfishwrapper.AccountService()
 	if err != nil {
@@ -0,0 +1,65 @@
+package redfish
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UserUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		user      string
+		pass      string
+		role      string
+		wantPatch map[string]interface{}
+		err       error
+	}{
+		{
+			"password and role",
+			"foo",
+			"calvin",
+			"Operator",
+			map[string]interface{}{"Password": "calvin", "RoleId": "Operator"},
+			nil,
+		},
+		{
+			"role only, the password is unchanged",
+			"foo",
+			"",
+			"Operator",
+			map[string]interface{}{"RoleId": "Operator"},
+			nil,
+		},
+		{
+			"user not present",
+			"bar",
+			"",
+			"Operator",
+			nil,
+			ErrUserNotPresent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountPatchBody = nil
+
+			ok, err := mockClient.UserUpdate(context.TODO(), tt.user, tt.pass, tt.role)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.False(t, ok)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.True(t, ok)
+
+			got := map[string]interface{}{}
+			assert.Nil(t, json.Unmarshal(accountPatchBody, &got))
+			assert.Equal(t, tt.wantPatch, got)
+		})
+	}
+}
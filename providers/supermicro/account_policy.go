@@ -0,0 +1,114 @@
+package supermicro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+// oemAccountService is the Supermicro OEM extension to the redfish AccountService.
+type oemAccountService struct {
+	Oem struct {
+		Supermicro struct {
+			PasswordComplexity *bool `json:"PasswordComplexity,omitempty"`
+		} `json:"Supermicro"`
+	} `json:"Oem"`
+}
+
+// oemPasswordComplexity returns the AccountService odata ID and the Supermicro OEM password complexity setting,
+// the setting is nil on BMCs without the OEM extension.
+func (c *Client) oemPasswordComplexity() (string, *bool, error) {
+	accountService, err := c.serviceClient.redfish.AccountService()
+	if err != nil {
+		return "", nil, errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	oem := &oemAccountService{}
+	if err := json.Unmarshal(accountService.RawData, oem); err != nil {
+		return "", nil, errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	return accountService.ODataID, oem.Oem.Supermicro.PasswordComplexity, nil
+}
+
+// GetAccountPolicy returns the redfish account policy and the Supermicro OEM password complexity setting,
+// the password complexity is nil on BMCs without the OEM extension.
+func (c *Client) GetAccountPolicy(ctx context.Context) (policy bmc.AccountPolicy, err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return policy, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	policy, err = c.serviceClient.redfish.GetAccountPolicy(ctx)
+	if err != nil {
+		return policy, err
+	}
+
+	_, complexity, err := c.oemPasswordComplexity()
+	if err != nil {
+		return policy, err
+	}
+
+	policy.PasswordComplexity = complexity
+
+	return policy, nil
+}
+
+// SetAccountPolicy sets the redfish account policy and the Supermicro OEM password complexity setting when given,
+// ErrNotImplemented is returned when password complexity is requested on BMCs without the OEM extension.
+func (c *Client) SetAccountPolicy(ctx context.Context, policy bmc.AccountPolicy) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	complexity := policy.PasswordComplexity
+	policy.PasswordComplexity = nil
+
+	var uri string
+
+	if complexity != nil {
+		var current *bool
+
+		uri, current, err = c.oemPasswordComplexity()
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			return errors.Wrap(bmclibErrs.ErrNotImplemented, "password complexity is not supported by this BMC")
+		}
+	}
+
+	if err := c.serviceClient.redfish.SetAccountPolicy(ctx, policy); err != nil {
+		return err
+	}
+
+	if complexity == nil {
+		return nil
+	}
+
+	payload := &oemAccountService{}
+	payload.Oem.Supermicro.PasswordComplexity = complexity
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+
+	resp, err := c.serviceClient.redfish.PatchWithHeaders(ctx, uri, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, err.Error())
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return errors.Wrap(bmclibErrs.ErrAccountPolicy, resp.Status)
+	}
+}
@@ -0,0 +1,128 @@
+package supermicro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func accountPolicyClient(t *testing.T, patched map[string][]map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redfish/v1/", endpointFunc(t, "x11/serviceroot.json"))
+
+	for endpoint, fixture := range map[string]string{
+		"/redfish/v1/AccountService": "x11/accountservice.json",
+		"/redfish/v1/SessionService": "x11/sessionservice.json",
+	} {
+		endpoint, fixture := endpoint, fixture
+
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				endpointFunc(t, fixture)(w, r)
+				return
+			}
+
+			payload := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatal(err)
+			}
+
+			patched[endpoint] = append(patched[endpoint], payload)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient := newBmcServiceClient(parsedURL.Hostname(), parsedURL.Port(), "", "", server.Client())
+	serviceClient.redfish = redfishwrapper.NewClient(
+		parsedURL.Hostname(),
+		parsedURL.Port(),
+		"",
+		"",
+		redfishwrapper.WithHTTPClient(server.Client()),
+		redfishwrapper.WithBasicAuthEnabled(true),
+	)
+
+	if err := serviceClient.redfish.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return &Client{serviceClient: serviceClient, log: logr.Discard()}, server.Close
+}
+
+func TestGetAccountPolicy(t *testing.T) {
+	client, closeFn := accountPolicyClient(t, map[string][]map[string]interface{}{})
+	defer closeFn()
+
+	policy, err := client.GetAccountPolicy(context.Background())
+	assert.Nil(t, err)
+
+	expected := bmc.AccountPolicy{
+		MinPasswordLength:        ptr(8),
+		PasswordComplexity:       ptr(false),
+		LockoutThreshold:         ptr(5),
+		LockoutDuration:          ptr(5 * time.Minute),
+		LockoutCounterResetAfter: ptr(5 * time.Minute),
+		SessionTimeout:           ptr(30 * time.Minute),
+	}
+
+	assert.Equal(t, expected, policy)
+}
+
+func TestSetAccountPolicy(t *testing.T) {
+	patched := map[string][]map[string]interface{}{}
+
+	client, closeFn := accountPolicyClient(t, patched)
+	defer closeFn()
+
+	policy := bmc.AccountPolicy{MinPasswordLength: ptr(12), PasswordComplexity: ptr(true), LockoutThreshold: ptr(3)}
+
+	err := client.SetAccountPolicy(context.Background(), policy)
+	assert.Nil(t, err)
+
+	expected := map[string][]map[string]interface{}{
+		"/redfish/v1/AccountService": {
+			{"MinPasswordLength": float64(12), "AccountLockoutThreshold": float64(3)},
+			{"Oem": map[string]interface{}{"Supermicro": map[string]interface{}{"PasswordComplexity": true}}},
+		},
+	}
+
+	assert.Equal(t, expected, patched)
+}
+
+func TestSetAccountPolicyLeavesPasswordComplexity(t *testing.T) {
+	patched := map[string][]map[string]interface{}{}
+
+	client, closeFn := accountPolicyClient(t, patched)
+	defer closeFn()
+
+	err := client.SetAccountPolicy(context.Background(), bmc.AccountPolicy{MinPasswordLength: ptr(12)})
+	assert.Nil(t, err)
+
+	expected := map[string][]map[string]interface{}{
+		"/redfish/v1/AccountService": {{"MinPasswordLength": float64(12)}},
+	}
+
+	assert.Equal(t, expected, patched)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
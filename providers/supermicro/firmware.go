@@ -2,7 +2,7 @@ package supermicro
 
 import (
 	"context"
-	"os"
+	"io"
 	"strings"
 	"time"
 
@@ -30,6 +30,15 @@ var (
 		"X12SPO-NTF",
 	}
 
+	// The X13 and H13 platforms share the redfish update service parameters across boards,
+	// and so all boards on these platforms are supported.
+	//
+	// board part number prefixes
+	supportedModelPrefixes = []string{
+		"X13",
+		"H13",
+	}
+
 	errUploadTaskIDExpected = errors.New("expected an firmware upload taskID")
 )
 
@@ -42,7 +51,7 @@ func (c *Client) FirmwareInstallSteps(ctx context.Context, component string) ([]
 	return c.bmc.firmwareInstallSteps(component)
 }
 
-func (c *Client) FirmwareUpload(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (c *Client) FirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
 	if err := c.serviceClient.supportsFirmwareInstall(c.bmc.deviceModel()); err != nil {
 		return "", err
 	}
@@ -53,7 +62,7 @@ func (c *Client) FirmwareUpload(ctx context.Context, component string, file *os.
 		return "", errors.New("remaining context deadline insufficient to perform update: " + time.Until(d).String())
 	}
 
-	return c.bmc.firmwareUpload(ctx, component, file)
+	return c.bmc.firmwareUpload(ctx, component, reader, size)
 }
 
 func (c *Client) FirmwareInstallUploaded(ctx context.Context, component, uploadTaskID string) (installTaskID string, err error) {
@@ -61,7 +70,8 @@ func (c *Client) FirmwareInstallUploaded(ctx context.Context, component, uploadT
 		return "", err
 	}
 
-	// x11's don't return a upload Task ID, since the upload mechanism is not redfish
+	// x11s do not return an upload Task ID for the BIOS, BMC, since the upload mechanism is not redfish,
+	// the x11 queryor validates the upload Task ID for the components installed over redfish.
 	if !strings.HasPrefix(strings.ToLower(c.bmc.deviceModel()), "x11") && uploadTaskID == "" {
 		return "", errors.Wrap(errUploadTaskIDExpected, "device model: "+c.bmc.deviceModel())
 	}
@@ -0,0 +1,98 @@
+package supermicro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	rfw "github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// The redfish update service firmware install flow is shared by the bmcQueryor implementations,
+// the queryors differ only in the components supported, the install targets and the OEM install parameters.
+
+var (
+	errUploadTaskIDEmpty = errors.New("firmware upload request returned empty firmware upload verify TaskID")
+)
+
+// firmwareTaskFunc returns true when the task is a firmware task for the component.
+type firmwareTaskFunc func(component string, t *schemas.Task) bool
+
+// redfishFirmwareUpload uploads the firmware to the redfish update service with the given parameters,
+// the upload is not attempted when a firmware task for the component is active.
+func (c *serviceClient) redfishFirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64, params *rfw.RedfishUpdateServiceParameters, firmwareTask firmwareTaskFunc) (string, error) {
+	if err := c.redfishFirmwareTaskActive(ctx, component, firmwareTask); err != nil {
+		return "", err
+	}
+
+	taskID, err := c.redfish.FirmwareUpload(ctx, reader, size, params)
+	if err != nil {
+		if strings.Contains(err.Error(), "OemFirmwareAlreadyInUpdateMode") {
+			return "", errors.Wrap(bmclibErrs.ErrBMCColdResetRequired, "BMC currently in update mode, either continue the update OR if no update is currently running - reset the BMC")
+		}
+
+		return "", errors.Wrap(err, "error in firmware upload")
+	}
+
+	if taskID == "" {
+		return "", errUploadTaskIDEmpty
+	}
+
+	return taskID, nil
+}
+
+// redfishFirmwareTaskActive returns an error when a firmware task for the component is active.
+func (c *serviceClient) redfishFirmwareTaskActive(ctx context.Context, component string, firmwareTask firmwareTaskFunc) error {
+	tasks, err := c.redfish.Tasks(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error querying redfish tasks")
+	}
+
+	for _, t := range tasks {
+		if stateFinalized(t.TaskState) {
+			continue
+		}
+
+		if firmwareTask(component, t) {
+			taskInfo := fmt.Sprintf("id: %s, name: %s, state: %s, status: %s", t.ID, t.Name, t.TaskState, t.TaskStatus)
+			return errors.Wrap(errors.New("A firmware task was found active for component: "+component), taskInfo)
+		}
+	}
+
+	return nil
+}
+
+// redfishFirmwareInstallUploaded starts the install of the uploaded firmware once the upload verify task has completed.
+func (c *serviceClient) redfishFirmwareInstallUploaded(ctx context.Context, uploadTaskID string) (string, error) {
+	if uploadTaskID == "" {
+		return "", errUploadTaskIDExpected
+	}
+
+	task, err := c.redfish.Task(ctx, uploadTaskID)
+	if err != nil {
+		e := fmt.Sprintf("error querying redfish tasks for firmware upload taskID: %s, err: %s", uploadTaskID, err.Error())
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareVerifyTask, e)
+	}
+
+	taskInfo := fmt.Sprintf("id: %s, state: %s, status: %s", task.ID, task.TaskState, task.TaskStatus)
+
+	if task.TaskState != schemas.CompletedTaskState || task.TaskStatus != "OK" {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareVerifyTask, taskInfo)
+	}
+
+	return c.redfish.StartUpdateForUploadedFirmware(ctx)
+}
+
+// redfishFirmwareTaskStatus returns the state of the firmware upload verify or install task.
+func (c *serviceClient) redfishFirmwareTaskStatus(ctx context.Context, taskID string) (constants.TaskState, string, error) {
+	if taskID == "" {
+		return "", "", errors.Wrap(bmclibErrs.ErrFirmwareTaskStatus, "taskID required")
+	}
+
+	return c.redfish.TaskStatus(ctx, taskID)
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
 	"github.com/bmc-toolbox/bmclib/v2/constants"
 	"github.com/bmc-toolbox/bmclib/v2/internal/httpclient"
 	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
@@ -56,8 +57,14 @@ var (
 		providers.FeatureGetBiosConfiguration,
 		providers.FeatureSetBiosConfiguration,
 		providers.FeatureSetBiosConfigurationFromFile,
+		providers.FeatureExportBiosConfiguration,
+		providers.FeatureTPM,
 		providers.FeatureResetBiosConfiguration,
 		providers.FeatureBootProgress,
+		providers.FeatureAlertDestinations,
+		providers.FeatureBMCTime,
+		providers.FeatureRemoteSyslog,
+		providers.FeatureAccountPolicy,
 	}
 )
 
@@ -76,6 +83,10 @@ var (
 //   - bios firmware install
 //   - bmc firmware install
 //   - floppy image mount
+//
+// baseboard part numbers: X13*, H13*
+//   - bios firmware install
+//   - bmc firmware install
 
 type Config struct {
 	HttpClient           *http.Client
@@ -114,7 +125,7 @@ type Client struct {
 
 type bmcQueryor interface {
 	firmwareInstallSteps(component string) ([]constants.FirmwareInstallStep, error)
-	firmwareUpload(ctx context.Context, component string, file *os.File) (taskID string, err error)
+	firmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error)
 	firmwareInstallUploaded(ctx context.Context, component, uploadTaskID string) (installTaskID string, err error)
 	firmwareTaskStatus(ctx context.Context, component, taskID string) (state constants.TaskState, status string, err error)
 	// query device model from the bmc
@@ -279,31 +290,81 @@ func (c *Client) GetBiosConfiguration(ctx context.Context) (biosConfig map[strin
 	return c.serviceClient.sum.GetBiosConfiguration(ctx)
 }
 
-// SetBiosConfiguration set bios configuration
-func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string) (err error) {
+// SetBiosConfiguration set bios configuration,
+// the host is rebooted to apply the changes unless the OnReset apply time is given
+func (c *Client) SetBiosConfiguration(ctx context.Context, biosConfig map[string]string, applyTime bmc.BiosApplyTime) (err error) {
 	if c.serviceClient == nil || c.serviceClient.sum == nil {
 		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
 	}
 
-	return c.serviceClient.sum.SetBiosConfiguration(ctx, biosConfig)
+	reboot, err := sumReboot(applyTime, true)
+	if err != nil {
+		return err
+	}
+
+	return c.serviceClient.sum.SetBiosConfiguration(ctx, biosConfig, reboot)
 }
 
-// SetBiosConfigurationFromFile sets the bios configuration from a raw vendor config file
+// SetBiosConfigurationFromFile sets the bios configuration from a raw sum XML config file,
+// or a vendor neutral YAML or JSON profile
 func (c *Client) SetBiosConfigurationFromFile(ctx context.Context, cfg string) (err error) {
 	if c.serviceClient == nil || c.serviceClient.sum == nil {
 		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
 	}
 
-	return c.serviceClient.sum.SetBiosConfigurationFromFile(ctx, cfg)
+	if strings.HasPrefix(strings.TrimSpace(cfg), "<") {
+		return c.serviceClient.sum.SetBiosConfigurationFromFile(ctx, cfg)
+	}
+
+	biosConfig, err := bmc.UnmarshalBiosProfile(cfg)
+	if err != nil {
+		return err
+	}
+
+	return c.serviceClient.sum.SetBiosConfiguration(ctx, biosConfig, true)
 }
 
-// ResetBiosConfiguration sets the bios configuration back to "factory" defaults
-func (c *Client) ResetBiosConfiguration(ctx context.Context) (err error) {
+// ExportBiosConfigurationToFile returns the bios configuration as a vendor neutral YAML or JSON profile
+func (c *Client) ExportBiosConfigurationToFile(ctx context.Context, format bmc.BiosConfigurationFormat) (cfg string, err error) {
+	if c.serviceClient == nil || c.serviceClient.sum == nil {
+		return "", errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	biosConfig, err := c.serviceClient.sum.GetBiosConfiguration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return bmc.MarshalBiosProfile(biosConfig, format)
+}
+
+// ResetBiosConfiguration sets the bios configuration back to "factory" defaults,
+// the host is rebooted to apply the defaults only with the Immediate apply time
+func (c *Client) ResetBiosConfiguration(ctx context.Context, applyTime bmc.BiosApplyTime) (err error) {
 	if c.serviceClient == nil || c.serviceClient.sum == nil {
 		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
 	}
 
-	return c.serviceClient.sum.ResetBiosConfiguration(ctx)
+	reboot, err := sumReboot(applyTime, false)
+	if err != nil {
+		return err
+	}
+
+	return c.serviceClient.sum.ResetBiosConfiguration(ctx, reboot)
+}
+
+// sumReboot returns if sum is to reboot the host for the apply time, sum cannot schedule a maintenance window.
+func sumReboot(applyTime bmc.BiosApplyTime, defaultReboot bool) (bool, error) {
+	switch applyTime.ApplyTime {
+	case "":
+		return defaultReboot, nil
+	case constants.Immediate:
+		return true, nil
+	case constants.OnReset:
+		return false, nil
+	default:
+		return false, errors.Wrap(bmclibErrs.ErrBiosApplyTime, string(applyTime.ApplyTime))
+	}
 }
 
 func (c *Client) bmcQueryor(ctx context.Context) (bmcQueryor, error) {
@@ -335,11 +396,14 @@ func (c *Client) bmcQueryor(ctx context.Context) (bmcQueryor, error) {
 	}
 
 	model := strings.ToLower(queryor.deviceModel())
-	if !strings.HasPrefix(model, "x12") && !strings.HasPrefix(model, "x11") {
-		return nil, errors.Wrap(ErrModelUnsupported, "expected one of X11* or X12*, got:"+model)
+	switch {
+	// the X13, H13 models are identified over redfish and managed by the x12 queryor
+	case strings.HasPrefix(model, "x11"), strings.HasPrefix(model, "x12"),
+		strings.HasPrefix(model, "x13"), strings.HasPrefix(model, "h13"):
+		return queryor, nil
 	}
 
-	return queryor, nil
+	return nil, errors.Wrap(ErrModelUnsupported, "expected one of X11*, X12*, X13* or H13*, got:"+model)
 }
 
 func parseToken(body []byte) string {
@@ -526,6 +590,12 @@ func (c *serviceClient) supportsFirmwareInstall(model string) error {
 		}
 	}
 
+	for _, prefix := range supportedModelPrefixes {
+		if strings.HasPrefix(strings.ToUpper(model), prefix) {
+			return nil
+		}
+	}
+
 	return errors.Wrap(ErrModelUnsupported, "firmware install not supported for: "+model)
 }
 
@@ -645,8 +715,91 @@ func (c *Client) SendNMI(ctx context.Context) error {
 	return c.serviceClient.redfish.SendNMI(ctx)
 }
 
-// GetBootProgress allows a caller to follow along as the system goes through its boot sequence
-func (c *Client) GetBootProgress() (*schemas.BootProgress, error) {
+// AlertDestinations returns the SNMP trap alert destinations
+func (c *Client) AlertDestinations(ctx context.Context) (destinations []bmc.AlertDestination, err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return nil, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.AlertDestinations(ctx)
+}
+
+// SetAlertDestination adds or removes an SNMP trap alert destination
+func (c *Client) SetAlertDestination(ctx context.Context, destination bmc.AlertDestination) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.SetAlertDestination(ctx, destination)
+}
+
+// AlertFilters is not implemented over redfish, the ipmitool provider implements the PEF filters
+func (c *Client) AlertFilters(ctx context.Context) (filters []bmc.AlertFilter, err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return nil, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.AlertFilters(ctx)
+}
+
+// SetAlertFilter is not implemented over redfish, the ipmitool provider implements the PEF filters
+func (c *Client) SetAlertFilter(ctx context.Context, filter bmc.AlertFilter) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.SetAlertFilter(ctx, filter)
+}
+
+// GetBMCTime returns the BMC time, time zone and NTP configuration
+func (c *Client) GetBMCTime(ctx context.Context) (bmcTime bmc.BMCTime, err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return bmc.BMCTime{}, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.GetBMCTime(ctx)
+}
+
+// SetBMCTime sets the BMC date and time
+func (c *Client) SetBMCTime(ctx context.Context, dateTime time.Time) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.SetBMCTime(ctx, dateTime)
+}
+
+// SetBMCTimeZone sets the BMC time zone
+func (c *Client) SetBMCTimeZone(ctx context.Context, timeZone string) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.SetBMCTimeZone(ctx, timeZone)
+}
+
+// SetNTPServers sets the BMC NTP configuration
+func (c *Client) SetNTPServers(ctx context.Context, enabled bool, servers []string) (err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.SetNTPServers(ctx, enabled, servers)
+}
+
+// GetBootProgress allows a caller to follow along as the system goes through its boot sequence,
+// the redfish boot progress is returned normalized to the bmc.BootProgress stages.
+func (c *Client) GetBootProgress(_ context.Context) (bmc.BootProgress, error) {
+	bp, err := c.bmc.getBootProgress()
+	if err != nil {
+		return bmc.BootProgress{}, err
+	}
+
+	return redfishwrapper.BootProgress(bp), nil
+}
+
+// RedfishBootProgress returns the boot progress as reported by the redfish service.
+func (c *Client) RedfishBootProgress() (*schemas.BootProgress, error) {
 	return c.bmc.getBootProgress()
 }
 
@@ -10,6 +10,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
 	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
@@ -417,3 +420,32 @@ func TestFetchScreenPreview(t *testing.T) {
 		})
 	}
 }
+
+func TestSumReboot(t *testing.T) {
+	testcases := []struct {
+		name          string
+		applyTime     constants.OperationApplyTime
+		defaultReboot bool
+		expected      bool
+		err           error
+	}{
+		{"default reboot", "", true, true, nil},
+		{"default no reboot", "", false, false, nil},
+		{"immediate", constants.Immediate, false, true, nil},
+		{"on reset", constants.OnReset, true, false, nil},
+		{"maintenance window", constants.AtMaintenanceWindowStart, true, false, bmclibErrs.ErrBiosApplyTime},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			reboot, err := sumReboot(bmc.BiosApplyTime{ApplyTime: tc.applyTime}, tc.defaultReboot)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, reboot)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package supermicro
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+// syslogEndpoint is the Supermicro OEM remote syslog resource under the redfish Manager.
+const syslogEndpoint = "/Oem/Supermicro/Syslog"
+
+// oemSyslog is the Supermicro OEM remote syslog resource.
+type oemSyslog struct {
+	EnableSyslog     bool   `json:"EnableSyslog"`
+	SyslogServer     string `json:"SyslogServer"`
+	SyslogPortNumber int    `json:"SyslogPortNumber,omitempty"`
+}
+
+func (c *Client) syslogURI(ctx context.Context) (string, error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return "", errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	managerID, err := c.serviceClient.redfish.ManagerOdataID(ctx)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	return managerID + syslogEndpoint, nil
+}
+
+// GetRemoteSyslog returns the remote syslog configuration from the Supermicro redfish OEM syslog resource.
+func (c *Client) GetRemoteSyslog(ctx context.Context) (syslog bmc.RemoteSyslog, err error) {
+	uri, err := c.syslogURI(ctx)
+	if err != nil {
+		return syslog, err
+	}
+
+	resp, err := c.serviceClient.redfish.Get(uri)
+	if err != nil {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, resp.Status)
+	}
+
+	data := &oemSyslog{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return syslog, errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	syslog = bmc.RemoteSyslog{Enabled: data.EnableSyslog, Port: data.SyslogPortNumber}
+	if data.SyslogServer != "" {
+		syslog.Servers = []string{data.SyslogServer}
+	}
+
+	return syslog, nil
+}
+
+// SetRemoteSyslog sets the Supermicro redfish OEM syslog resource, a single syslog server is supported.
+func (c *Client) SetRemoteSyslog(ctx context.Context, syslog bmc.RemoteSyslog) (err error) {
+	if len(syslog.Servers) > 1 {
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, "a single syslog server is supported")
+	}
+
+	uri, err := c.syslogURI(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload := &oemSyslog{EnableSyslog: syslog.Enabled, SyslogPortNumber: syslog.Port}
+	if len(syslog.Servers) == 1 {
+		payload.SyslogServer = syslog.Servers[0]
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+
+	resp, err := c.serviceClient.redfish.PatchWithHeaders(ctx, uri, json.RawMessage(b), map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, err.Error())
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	default:
+		return errors.Wrap(bmclibErrs.ErrRemoteSyslog, resp.Status)
+	}
+}
@@ -0,0 +1,123 @@
+package supermicro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+func syslogClient(t *testing.T, patched map[string]interface{}) (*Client, func()) {
+	t.Helper()
+
+	handlers := map[string]string{
+		"/redfish/v1/":           "x11/serviceroot.json",
+		"/redfish/v1/Managers":   "x11/managers.json",
+		"/redfish/v1/Managers/1": "x11/managers_1.json",
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, fixture := range handlers {
+		mux.HandleFunc(endpoint, endpointFunc(t, fixture))
+	}
+
+	mux.HandleFunc("/redfish/v1/Managers/1/Oem/Supermicro/Syslog", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			endpointFunc(t, "x11/managers_1_syslog.json")(w, r)
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+			t.Fatal(err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient := newBmcServiceClient(parsedURL.Hostname(), parsedURL.Port(), "", "", server.Client())
+	serviceClient.redfish = redfishwrapper.NewClient(
+		parsedURL.Hostname(),
+		parsedURL.Port(),
+		"",
+		"",
+		redfishwrapper.WithHTTPClient(server.Client()),
+		redfishwrapper.WithBasicAuthEnabled(true),
+	)
+
+	if err := serviceClient.redfish.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return &Client{serviceClient: serviceClient, log: logr.Discard()}, server.Close
+}
+
+func TestGetRemoteSyslog(t *testing.T) {
+	client, closeFn := syslogClient(t, map[string]interface{}{})
+	defer closeFn()
+
+	syslog, err := client.GetRemoteSyslog(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, bmc.RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.50"}, Port: 514}, syslog)
+}
+
+func TestSetRemoteSyslog(t *testing.T) {
+	tests := map[string]struct {
+		syslog bmc.RemoteSyslog
+		expect map[string]interface{}
+		err    error
+	}{
+		"enable": {
+			syslog: bmc.RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.51"}, Port: 1514},
+			expect: map[string]interface{}{
+				"EnableSyslog":     true,
+				"SyslogServer":     "192.0.2.51",
+				"SyslogPortNumber": float64(1514),
+			},
+		},
+		"disable": {
+			syslog: bmc.RemoteSyslog{},
+			expect: map[string]interface{}{
+				"EnableSyslog": false,
+				"SyslogServer": "",
+			},
+		},
+		"multiple servers": {
+			syslog: bmc.RemoteSyslog{Enabled: true, Servers: []string{"192.0.2.51", "192.0.2.52"}},
+			err:    bmclibErrs.ErrRemoteSyslog,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			patched := map[string]interface{}{}
+
+			client, closeFn := syslogClient(t, patched)
+			defer closeFn()
+
+			err := client.SetRemoteSyslog(context.Background(), tc.syslog)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, patched)
+		})
+	}
+}
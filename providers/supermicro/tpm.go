@@ -0,0 +1,48 @@
+package supermicro
+
+import (
+	"context"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	"github.com/pkg/errors"
+
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+)
+
+const (
+	// sumTPMPendingOperation is the Trusted Computing BIOS menu setting that schedules a TPM operation on the next reboot
+	sumTPMPendingOperation = "raw:Trusted Computing, Pending Operation"
+	sumTPMClear            = "TPM Clear"
+)
+
+// GetTPM returns the TPM state from the redfish trusted modules.
+func (c *Client) GetTPM(ctx context.Context) (tpm bmc.TPM, err error) {
+	if c.serviceClient == nil || c.serviceClient.redfish == nil {
+		return tpm, errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.redfish.GetTPM(ctx)
+}
+
+// SetTPM enables or disables the TPM through sum, applied on the next host reboot.
+func (c *Client) SetTPM(ctx context.Context, enabled bool) (err error) {
+	if c.serviceClient == nil || c.serviceClient.sum == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	state := "Disabled"
+	if enabled {
+		state = "Enabled"
+	}
+
+	return c.serviceClient.sum.SetBiosConfiguration(ctx, map[string]string{"tpm": state}, false)
+}
+
+// ClearTPM schedules the TPM Clear pending operation through sum, applied on the next host reboot.
+func (c *Client) ClearTPM(ctx context.Context) (err error) {
+	if c.serviceClient == nil || c.serviceClient.sum == nil {
+		return errors.Wrap(bmclibErrs.ErrLoginFailed, "client not initialized")
+	}
+
+	return c.serviceClient.sum.SetBiosConfiguration(ctx, map[string]string{sumTPMPendingOperation: sumTPMClear}, false)
+}
@@ -5,8 +5,8 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
-	"os"
 	"slices"
 	"strings"
 
@@ -136,7 +136,8 @@ func (c *x11) fruInfo(ctx context.Context) (*FruInfo, error) {
 func (c *x11) supportsInstall(component string) error {
 	errComponentNotSupported := fmt.Errorf("component %s on device %s not supported", component, c.model)
 
-	supported := []string{common.SlugBIOS, common.SlugBMC}
+	// the CPLD, NIC firmware is installed through the redfish update service
+	supported := []string{common.SlugBIOS, common.SlugBMC, common.SlugCPLD, common.SlugNIC}
 	if !slices.Contains(supported, strings.ToUpper(component)) {
 		return errComponentNotSupported
 	}
@@ -149,6 +150,10 @@ func (c *x11) firmwareInstallSteps(component string) ([]constants.FirmwareInstal
 		return nil, err
 	}
 
+	if c.redfishComponent(component) {
+		return c.redfishFirmwareInstallSteps(component), nil
+	}
+
 	steps := []constants.FirmwareInstallStep{
 		constants.FirmwareInstallStepUpload,
 		constants.FirmwareInstallStepInstallUploaded,
@@ -164,20 +169,22 @@ func (c *x11) firmwareInstallSteps(component string) ([]constants.FirmwareInstal
 	return steps, nil
 }
 
-func (c *x11) firmwareUpload(ctx context.Context, component string, file *os.File) (string, error) {
+func (c *x11) firmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (string, error) {
 	component = strings.ToUpper(component)
 
 	switch component {
 	case common.SlugBIOS:
-		return "", c.firmwareUploadBIOS(ctx, file)
+		return "", c.firmwareUploadBIOS(ctx, reader)
 	case common.SlugBMC:
-		return "", c.firmwareUploadBMC(ctx, file)
+		return "", c.firmwareUploadBMC(ctx, reader)
+	case common.SlugCPLD, common.SlugNIC:
+		return c.redfishFirmwareUpload(ctx, component, reader, size)
 	}
 
 	return "", errors.Wrap(bmclibErrs.ErrFirmwareInstall, "component unsupported: "+component)
 }
 
-func (c *x11) firmwareInstallUploaded(ctx context.Context, component, _ string) (string, error) {
+func (c *x11) firmwareInstallUploaded(ctx context.Context, component, uploadTaskID string) (string, error) {
 	component = strings.ToUpper(component)
 
 	switch component {
@@ -185,12 +192,14 @@ func (c *x11) firmwareInstallUploaded(ctx context.Context, component, _ string)
 		return "", c.firmwareInstallUploadedBIOS(ctx)
 	case common.SlugBMC:
 		return "", c.initiateBMCFirmwareInstall(ctx)
+	case common.SlugCPLD, common.SlugNIC:
+		return c.redfishFirmwareInstallUploaded(ctx, uploadTaskID)
 	}
 
 	return "", errors.Wrap(bmclibErrs.ErrFirmwareInstallUploaded, "component unsupported: "+component)
 }
 
-func (c *x11) firmwareTaskStatus(ctx context.Context, component, _ string) (state constants.TaskState, status string, err error) {
+func (c *x11) firmwareTaskStatus(ctx context.Context, component, taskID string) (state constants.TaskState, status string, err error) {
 	component = strings.ToUpper(component)
 
 	switch component {
@@ -198,6 +207,8 @@ func (c *x11) firmwareTaskStatus(ctx context.Context, component, _ string) (stat
 		return c.statusBIOSFirmwareInstall(ctx)
 	case common.SlugBMC:
 		return c.statusBMCFirmwareInstall(ctx)
+	case common.SlugCPLD, common.SlugNIC:
+		return c.redfishFirmwareTaskStatus(ctx, taskID)
 	}
 
 	return "", "", errors.Wrap(bmclibErrs.ErrFirmwareTaskStatus, "component unsupported: "+component)
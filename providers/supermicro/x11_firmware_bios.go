@@ -8,14 +8,13 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/internal"
 	"github.com/pkg/errors"
 )
 
@@ -221,12 +220,8 @@ func (c *x11) uploadBIOSFirmware(ctx context.Context, fwReader io.Reader) error
 
 		switch part.name {
 		case "bios_rom":
-			file, ok := part.data.(*os.File)
-			if !ok {
-				return errors.Wrap(ErrMultipartForm, "expected io.Reader on firmware image file")
-			}
-
-			if partWriter, err = payloadWriter.CreateFormFile(part.name, filepath.Base(file.Name())); err != nil {
+			fileName := internal.ReaderFileName(part.data, "bios.bin")
+			if partWriter, err = payloadWriter.CreateFormFile(part.name, fileName); err != nil {
 				return errors.Wrap(ErrMultipartForm, err.Error())
 			}
 
@@ -8,8 +8,6 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +16,7 @@ import (
 
 	"github.com/bmc-toolbox/bmclib/v2/constants"
 	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/bmc-toolbox/bmclib/v2/internal"
 )
 
 var (
@@ -132,12 +131,8 @@ func (c *x11) uploadBMCFirmware(ctx context.Context, fwReader io.Reader) error {
 
 		switch part.name {
 		case "fw_image":
-			file, ok := part.data.(*os.File)
-			if !ok {
-				return errors.Wrap(ErrMultipartForm, "expected io.Reader on firmware image file")
-			}
-
-			if partWriter, err = payloadWriter.CreateFormFile(part.name, filepath.Base(file.Name())); err != nil {
+			fileName := internal.ReaderFileName(part.data, "bmc.bin")
+			if partWriter, err = payloadWriter.CreateFormFile(part.name, fileName); err != nil {
 				return errors.Wrap(ErrMultipartForm, err.Error())
 			}
 
@@ -0,0 +1,119 @@
+package supermicro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	rfw "github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/bmc-toolbox/common"
+	"github.com/pkg/errors"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// The X11 CGI firmware install flow is limited to the BIOS and BMC,
+// other components are installed through the redfish update service on boards that list them in the firmware inventory.
+
+var (
+	errRedfishComponentUnsupported = errors.New("component firmware install over redfish not supported on this board")
+)
+
+// redfishComponent returns true for components installed through the redfish update service.
+func (c *x11) redfishComponent(component string) bool {
+	switch strings.ToUpper(component) {
+	case common.SlugCPLD, common.SlugNIC:
+		return true
+	default:
+		return false
+	}
+}
+
+// firmwareInventoryMatch returns true when the firmware inventory item is for the component.
+func firmwareInventoryMatch(component string, item *schemas.SoftwareInventory) bool {
+	contains := func(s, substr string) bool {
+		return strings.Contains(strings.ToUpper(s), substr)
+	}
+
+	switch strings.ToUpper(component) {
+	case common.SlugCPLD:
+		return contains(item.ID, "CPLD") || contains(item.Name, "CPLD")
+	case common.SlugNIC:
+		return contains(item.ID, "NIC") ||
+			contains(item.Name, "NIC") ||
+			contains(item.Name, "BROADCOM") ||
+			contains(item.Manufacturer, "BROADCOM")
+	default:
+		return false
+	}
+}
+
+// redfishOdataID returns the firmware inventory OData ID of the component to be targeted by the firmware install.
+func (c *x11) redfishOdataID(ctx context.Context, component string) (string, error) {
+	if err := c.redfishSession(ctx); err != nil {
+		return "", err
+	}
+
+	updateService, err := c.redfish.UpdateService()
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishUpdateService, err.Error())
+	}
+
+	inventory, err := updateService.FirmwareInventory()
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrRedfishSoftwareInventory, err.Error())
+	}
+
+	for _, item := range inventory {
+		if !item.Updateable {
+			continue
+		}
+
+		if firmwareInventoryMatch(component, item) {
+			return item.ODataID, nil
+		}
+	}
+
+	return "", errors.Wrap(errRedfishComponentUnsupported, fmt.Sprintf("component: %s, model: %s", component, c.model))
+}
+
+func (c *x11) redfishFirmwareInstallSteps(component string) []constants.FirmwareInstallStep {
+	steps := []constants.FirmwareInstallStep{
+		constants.FirmwareInstallStepUpload,
+		constants.FirmwareInstallStepUploadStatus,
+		constants.FirmwareInstallStepInstallUploaded,
+		constants.FirmwareInstallStepInstallStatus,
+	}
+
+	// The CPLD is flashed with the host powered off
+	if strings.EqualFold(component, common.SlugCPLD) {
+		steps = append([]constants.FirmwareInstallStep{constants.FirmwareInstallStepPowerOffHost}, steps...)
+	}
+
+	return steps
+}
+
+func (c *x11) redfishFirmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (string, error) {
+	targetID, err := c.redfishOdataID(ctx, component)
+	if err != nil {
+		return "", errors.Wrap(bmclibErrs.ErrFirmwareUpload, err.Error())
+	}
+
+	params := &rfw.RedfishUpdateServiceParameters{
+		OperationApplyTime: constants.OnStartUpdateRequest,
+		Targets:            []string{targetID},
+		Oem:                []byte(`{}`),
+	}
+
+	return c.serviceClient.redfishFirmwareUpload(ctx, component, reader, size, params, x11FirmwareTask)
+}
+
+// x11FirmwareTask returns true for any firmware verify or update task.
+//
+// The X11 redfish task names for components other than the BIOS and BMC are not consistent across boards,
+// and so any active verify or update task is considered to block the install.
+func x11FirmwareTask(_ string, t *schemas.Task) bool {
+	return strings.Contains(t.Name, "Verify") || strings.Contains(t.Name, "Update")
+}
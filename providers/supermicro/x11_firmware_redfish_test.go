@@ -0,0 +1,213 @@
+package supermicro
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/bmc-toolbox/common"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func x11RedfishServer(t *testing.T) (*x11, func()) {
+	t.Helper()
+
+	handlers := map[string]string{
+		"/redfish/v1/":                                                 "x11/serviceroot.json",
+		"/redfish/v1/UpdateService":                                    "x11/updateservice.json",
+		"/redfish/v1/UpdateService/FirmwareInventory":                  "x11/firmwareinventory.json",
+		"/redfish/v1/UpdateService/FirmwareInventory/BMC":              "x11/firmwareinventory_bmc.json",
+		"/redfish/v1/UpdateService/FirmwareInventory/BIOS":             "x11/firmwareinventory_bios.json",
+		"/redfish/v1/UpdateService/FirmwareInventory/CPLD_Motherboard": "x11/firmwareinventory_cpld_motherboard.json",
+		"/redfish/v1/TaskService":                                      "x11/taskservice.json",
+		"/redfish/v1/TaskService/Tasks":                                "x11/tasks.json",
+		"/redfish/v1/TaskService/Tasks/2":                              "x11/tasks_2.json",
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, fixture := range handlers {
+		mux.HandleFunc(endpoint, endpointFunc(t, fixture))
+	}
+
+	mux.HandleFunc("/redfish/v1/UpdateService/upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Contains(t, string(b), `"Targets":["/redfish/v1/UpdateService/FirmwareInventory/CPLD_Motherboard"]`)
+		assert.Contains(t, string(b), `"@Redfish.OperationApplyTime":"OnStartUpdateRequest"`)
+		assert.Contains(t, string(b), "cpld firmware")
+
+		w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/2")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	mux.HandleFunc("/redfish/v1/UpdateService/Actions/UpdateService.StartUpdate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		w.Header().Set("Location", "/redfish/v1/TaskService/Tasks/3")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient := newBmcServiceClient(parsedURL.Hostname(), parsedURL.Port(), "", "", server.Client())
+	serviceClient.redfish = redfishwrapper.NewClient(
+		parsedURL.Hostname(),
+		parsedURL.Port(),
+		"",
+		"",
+		redfishwrapper.WithHTTPClient(server.Client()),
+		redfishwrapper.WithBasicAuthEnabled(true),
+	)
+
+	if err := serviceClient.redfish.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newX11Client(serviceClient, logr.Discard()).(*x11)
+	client.model = "x11dph-t"
+
+	return client, server.Close
+}
+
+func TestX11RedfishOdataID(t *testing.T) {
+	testcases := []struct {
+		name      string
+		component string
+		expect    string
+		err       error
+	}{
+		{
+			"cpld",
+			common.SlugCPLD,
+			"/redfish/v1/UpdateService/FirmwareInventory/CPLD_Motherboard",
+			nil,
+		},
+		{
+			"nic not in firmware inventory",
+			common.SlugNIC,
+			"",
+			errRedfishComponentUnsupported,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, closeFn := x11RedfishServer(t)
+			defer closeFn()
+
+			got, err := client.redfishOdataID(context.Background(), tc.component)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestX11FirmwareInstallSteps(t *testing.T) {
+	testcases := []struct {
+		name      string
+		component string
+		expect    []constants.FirmwareInstallStep
+		err       bool
+	}{
+		{
+			"bmc",
+			common.SlugBMC,
+			[]constants.FirmwareInstallStep{
+				constants.FirmwareInstallStepUpload,
+				constants.FirmwareInstallStepInstallUploaded,
+				constants.FirmwareInstallStepInstallStatus,
+				constants.FirmwareInstallStepResetBMCOnInstallFailure,
+			},
+			false,
+		},
+		{
+			"cpld",
+			common.SlugCPLD,
+			[]constants.FirmwareInstallStep{
+				constants.FirmwareInstallStepPowerOffHost,
+				constants.FirmwareInstallStepUpload,
+				constants.FirmwareInstallStepUploadStatus,
+				constants.FirmwareInstallStepInstallUploaded,
+				constants.FirmwareInstallStepInstallStatus,
+			},
+			false,
+		},
+		{
+			"nic",
+			"nic",
+			[]constants.FirmwareInstallStep{
+				constants.FirmwareInstallStepUpload,
+				constants.FirmwareInstallStepUploadStatus,
+				constants.FirmwareInstallStepInstallUploaded,
+				constants.FirmwareInstallStepInstallStatus,
+			},
+			false,
+		},
+		{
+			"unsupported",
+			common.SlugGPU,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &x11{model: "x11dph-t"}
+
+			got, err := client.firmwareInstallSteps(tc.component)
+			if tc.err {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+}
+
+func TestX11RedfishFirmwareInstall(t *testing.T) {
+	client, closeFn := x11RedfishServer(t)
+	defer closeFn()
+
+	ctx := context.Background()
+	payload := []byte("cpld firmware")
+
+	uploadTaskID, err := client.firmwareUpload(ctx, common.SlugCPLD, bytes.NewReader(payload), int64(len(payload)))
+	assert.Nil(t, err)
+	assert.Equal(t, "2", uploadTaskID)
+
+	state, _, err := client.firmwareTaskStatus(ctx, common.SlugCPLD, uploadTaskID)
+	assert.Nil(t, err)
+	assert.Equal(t, constants.Complete, state)
+
+	installTaskID, err := client.firmwareInstallUploaded(ctx, common.SlugCPLD, uploadTaskID)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", installTaskID)
+
+	_, err = client.firmwareInstallUploaded(ctx, common.SlugCPLD, "")
+	assert.ErrorIs(t, err, errUploadTaskIDExpected)
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
+	"io"
 	"slices"
 	"strings"
 
@@ -17,6 +17,8 @@ import (
 	"github.com/stmcginnis/gofish/schemas"
 )
 
+// x12 implements the bmcQueryor for the X12 platforms and the X13 (Intel) and H13 (AMD) platforms,
+// which are managed through redfish alike and differ only in the firmware install parameters and boot progress.
 type x12 struct {
 	*serviceClient
 	model string
@@ -53,9 +55,18 @@ func (c *x12) queryDeviceModel(ctx context.Context) (string, error) {
 	return c.model, nil
 }
 
-var (
-	errUploadTaskIDEmpty = errors.New("firmware upload request returned empty firmware upload verify TaskID")
-)
+// x13 returns true for the X13 and H13 platform models,
+// the redfish firmware install parameters on these platforms are not board specific.
+func (c *x12) x13() bool {
+	model := strings.ToLower(c.model)
+
+	return strings.HasPrefix(model, "x13") || strings.HasPrefix(model, "h13")
+}
+
+// amd returns true for the H13 AMD platform models.
+func (c *x12) amd() bool {
+	return strings.HasPrefix(strings.ToLower(c.model), "h13")
+}
 
 func (c *x12) supportsInstall(component string) error {
 	errComponentNotSupported := fmt.Errorf("component %s on device %s not supported", component, c.model)
@@ -82,16 +93,11 @@ func (c *x12) firmwareInstallSteps(component string) ([]constants.FirmwareInstal
 }
 
 // upload firmware
-func (c *x12) firmwareUpload(ctx context.Context, component string, file *os.File) (taskID string, err error) {
+func (c *x12) firmwareUpload(ctx context.Context, component string, reader io.Reader, size int64) (taskID string, err error) {
 	if err = c.supportsInstall(component); err != nil {
 		return "", err
 	}
 
-	err = c.firmwareTaskActive(ctx, component)
-	if err != nil {
-		return "", err
-	}
-
 	targetID, err := c.redfishOdataID(ctx, component)
 	if err != nil {
 		return "", err
@@ -102,48 +108,11 @@ func (c *x12) firmwareUpload(ctx context.Context, component string, file *os.Fil
 		return "", err
 	}
 
-	taskID, err = c.redfish.FirmwareUpload(ctx, file, params)
-	if err != nil {
-		if strings.Contains(err.Error(), "OemFirmwareAlreadyInUpdateMode") {
-			return "", errors.Wrap(brrs.ErrBMCColdResetRequired, "BMC currently in update mode, either continue the update OR if no update is currently running - reset the BMC")
-		}
-
-		return "", errors.Wrap(err, "error in firmware upload")
-	}
-
-	if taskID == "" {
-		return "", errUploadTaskIDEmpty
-	}
-
-	return taskID, nil
-}
-
-// returns an error when a bmc firmware install is active
-func (c *x12) firmwareTaskActive(ctx context.Context, component string) error {
-	tasks, err := c.redfish.Tasks(ctx)
-	if err != nil {
-		return errors.Wrap(err, "error querying redfish tasks")
-	}
-
-	for _, t := range tasks {
-		t := t
-
-		if stateFinalized(t.TaskState) {
-			continue
-		}
-
-		if err := noTasksRunning(component, t); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return c.redfishFirmwareUpload(ctx, component, reader, size, params, x12FirmwareTask)
 }
 
-// noTasksRunning returns an error if a firmware related task was found active
-func noTasksRunning(component string, t *schemas.Task) error {
-	errTaskActive := errors.New("A firmware task was found active for component: " + component)
-
+// x12FirmwareTask returns true for the verify and update tasks of the component.
+func x12FirmwareTask(component string, t *schemas.Task) bool {
 	const (
 		// The redfish task name when the BMC is verifies the uploaded BMC firmware.
 		verifyBMCFirmware = "BMC Verify"
@@ -155,26 +124,13 @@ func noTasksRunning(component string, t *schemas.Task) error {
 		updateBIOSFirmware = "BIOS Update"
 	)
 
-	var verifyTaskName, updateTaskName string
-
 	switch strings.ToUpper(component) {
 	case common.SlugBMC:
-		verifyTaskName = verifyBMCFirmware
-		updateTaskName = updateBMCFirmware
+		return t.Name == verifyBMCFirmware || t.Name == updateBMCFirmware
 	case common.SlugBIOS:
-		verifyTaskName = verifyBIOSFirmware
-		updateTaskName = updateBIOSFirmware
-	}
-
-	taskInfo := fmt.Sprintf("id: %s, state: %s, status: %s", t.ID, t.TaskState, t.TaskStatus)
-
-	switch t.Name {
-	case verifyTaskName:
-		return errors.Wrap(errTaskActive, taskInfo)
-	case updateTaskName:
-		return errors.Wrap(errTaskActive, taskInfo)
+		return t.Name == verifyBIOSFirmware || t.Name == updateBIOSFirmware
 	default:
-		return nil
+		return false
 	}
 }
 
@@ -200,6 +156,10 @@ type OEM struct {
 
 // redfish OEM fw install parameters
 func (c *x12) biosFwInstallParams() (map[string]bool, error) {
+	if c.x13() {
+		return c.x13BiosFwInstallParams(), nil
+	}
+
 	switch c.model {
 	case "x12spo-ntf":
 		return map[string]bool{
@@ -226,27 +186,55 @@ func (c *x12) biosFwInstallParams() (map[string]bool, error) {
 	}
 }
 
+// redfish OEM fw install parameters on the X13 and H13 platforms
+//
+// The H13 AMD platforms have no Intel ME region to preserve.
+func (c *x12) x13BiosFwInstallParams() map[string]bool {
+	params := map[string]bool{
+		"PreserveNVRAM":      false,
+		"PreserveSMBIOS":     true,
+		"PreserveOA":         true,
+		"PreserveSETUPCONF":  true,
+		"PreserveSETUPPWD":   true,
+		"PreserveSECBOOTKEY": true,
+		"PreserveBOOTCONF":   true,
+		"BackupBIOS":         false,
+	}
+
+	if !c.amd() {
+		params["PreserveME"] = false
+	}
+
+	return params
+}
+
 // redfish OEM fw install parameters
 func (c *x12) bmcFwInstallParams() map[string]bool {
-	return map[string]bool{
+	params := map[string]bool{
 		"PreserveCfg": true,
 		"PreserveSdr": true,
 		"PreserveSsl": true,
 	}
+
+	if c.x13() {
+		params["BackupBMC"] = false
+	}
+
+	return params
 }
 
 func (c *x12) redfishParameters(component, targetODataID string) (*rfw.RedfishUpdateServiceParameters, error) {
-	errUnsupported := errors.New("redfish parameters for x12 hardware component not supported: " + component)
+	errUnsupported := errors.New("redfish parameters for " + c.model + " hardware component not supported: " + component)
 
 	oem := OEM{}
 
-	biosInstallParams, err := c.biosFwInstallParams()
-	if err != nil {
-		return nil, err
-	}
-
 	switch strings.ToUpper(component) {
 	case common.SlugBIOS:
+		biosInstallParams, err := c.biosFwInstallParams()
+		if err != nil {
+			return nil, err
+		}
+
 		oem.Supermicro.BIOS = biosInstallParams
 	case common.SlugBMC:
 		oem.Supermicro.BMC = c.bmcFwInstallParams()
@@ -288,31 +276,28 @@ func (c *x12) firmwareInstallUploaded(ctx context.Context, component, uploadTask
 		return "", err
 	}
 
-	task, err := c.redfish.Task(ctx, uploadTaskID)
-	if err != nil {
-		e := fmt.Sprintf("error querying redfish tasks for firmware upload taskID: %s, err: %s", uploadTaskID, err.Error())
-		return "", errors.Wrap(brrs.ErrFirmwareVerifyTask, e)
-	}
-
-	taskInfo := fmt.Sprintf("id: %s, state: %s, status: %s", task.ID, task.TaskState, task.TaskStatus)
-
-	if task.TaskState != schemas.CompletedTaskState {
-		return "", errors.Wrap(brrs.ErrFirmwareVerifyTask, taskInfo)
-	}
-
-	if task.TaskStatus != "OK" {
-		return "", errors.Wrap(brrs.ErrFirmwareVerifyTask, taskInfo)
-	}
-
-	return c.redfish.StartUpdateForUploadedFirmware(ctx)
+	return c.redfishFirmwareInstallUploaded(ctx, uploadTaskID)
 }
 
+// firmwareTaskStatus returns the firmware task state
+//
+// The X13 and H13 BIOS update task completes once the BIOS flash is written,
+// the new BIOS firmware only takes effect once the host is power cycled.
 func (c *x12) firmwareTaskStatus(ctx context.Context, component, taskID string) (state constants.TaskState, status string, err error) {
 	if err = c.supportsInstall(component); err != nil {
 		return "", "", errors.Wrap(brrs.ErrFirmwareTaskStatus, err.Error())
 	}
 
-	return c.redfish.TaskStatus(ctx, taskID)
+	state, status, err = c.redfishFirmwareTaskStatus(ctx, taskID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if c.x13() && state == constants.Complete && strings.EqualFold(component, common.SlugBIOS) {
+		return constants.PowerCycleHost, status, nil
+	}
+
+	return state, status, nil
 }
 
 func (c *x12) getBootProgress() (*schemas.BootProgress, error) {
@@ -320,6 +305,11 @@ func (c *x12) getBootProgress() (*schemas.BootProgress, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if len(bps) == 0 {
+		return nil, errors.New("no boot progress returned for system: " + c.model)
+	}
+
 	return bps[0], nil
 }
 
@@ -329,6 +319,18 @@ func (c *x12) bootComplete() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+
+	// the X13 and H13 BMCs move on to the OS boot states when the host boots an OS that reports its state
+	if c.x13() {
+		complete := []schemas.BootProgressTypes{
+			schemas.SystemHardwareInitializationCompleteBootProgressTypes,
+			schemas.OSBootStartedBootProgressTypes,
+			schemas.OSRunningBootProgressTypes,
+		}
+
+		return slices.Contains(complete, bp.LastState), nil
+	}
+
 	// we determined this by experiment on X12STH-SYS with redfish 1.14.0
 	return bp.LastState == schemas.SystemHardwareInitializationCompleteBootProgressTypes, nil
 }
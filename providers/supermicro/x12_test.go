@@ -0,0 +1,174 @@
+package supermicro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/constants"
+	"github.com/bmc-toolbox/bmclib/v2/internal/redfishwrapper"
+	"github.com/bmc-toolbox/common"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+func x13Server(t *testing.T) (*x12, func()) {
+	t.Helper()
+
+	handlers := map[string]string{
+		"/redfish/v1/":                    "x13/serviceroot.json",
+		"/redfish/v1/Systems":             "x13/systems.json",
+		"/redfish/v1/Systems/1":           "x13/systems_1.json",
+		"/redfish/v1/TaskService":         "x13/taskservice.json",
+		"/redfish/v1/TaskService/Tasks":   "x13/tasks.json",
+		"/redfish/v1/TaskService/Tasks/1": "x13/tasks_1.json",
+	}
+
+	mux := http.NewServeMux()
+	for endpoint, fixture := range handlers {
+		mux.HandleFunc(endpoint, endpointFunc(t, fixture))
+	}
+
+	server := httptest.NewTLSServer(mux)
+
+	parsedURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient := newBmcServiceClient(parsedURL.Hostname(), parsedURL.Port(), "", "", server.Client())
+	serviceClient.redfish = redfishwrapper.NewClient(parsedURL.Hostname(), parsedURL.Port(), "", "", redfishwrapper.WithHTTPClient(server.Client()))
+	if err := serviceClient.redfish.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newX12Client(serviceClient, logr.Discard()).(*x12)
+
+	// the X13 platform behaviour is picked from the model identified by the queryor
+	if _, err := client.queryDeviceModel(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client, server.Close
+}
+
+func TestX13QueryDeviceModel(t *testing.T) {
+	client, closeFn := x13Server(t)
+	defer closeFn()
+
+	model, err := client.queryDeviceModel(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "X13DEM", model)
+	assert.Nil(t, client.supportsFirmwareInstall(model))
+}
+
+func TestX13RedfishParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		model         string
+		component     string
+		expectOem     string
+		errorContains string
+	}{
+		{
+			"x13 bios",
+			"X13DEM",
+			common.SlugBIOS,
+			`{"Supermicro":{"BIOS":{"BackupBIOS":false,"PreserveBOOTCONF":true,"PreserveME":false,"PreserveNVRAM":false,"PreserveOA":true,"PreserveSECBOOTKEY":true,"PreserveSETUPCONF":true,"PreserveSETUPPWD":true,"PreserveSMBIOS":true}}}`,
+			"",
+		},
+		{
+			"h13 bios has no ME region",
+			"H13SSL-N",
+			"bios",
+			`{"Supermicro":{"BIOS":{"BackupBIOS":false,"PreserveBOOTCONF":true,"PreserveNVRAM":false,"PreserveOA":true,"PreserveSECBOOTKEY":true,"PreserveSETUPCONF":true,"PreserveSETUPPWD":true,"PreserveSMBIOS":true}}}`,
+			"",
+		},
+		{
+			"bmc",
+			"H13SSL-N",
+			common.SlugBMC,
+			`{"Supermicro":{"BMC":{"BackupBMC":false,"PreserveCfg":true,"PreserveSdr":true,"PreserveSsl":true}}}`,
+			"",
+		},
+		{
+			"x12 bmc",
+			"x12sth-sys",
+			common.SlugBMC,
+			`{"Supermicro":{"BMC":{"PreserveCfg":true,"PreserveSdr":true,"PreserveSsl":true}}}`,
+			"",
+		},
+		{
+			"unsupported component",
+			"X13DEM",
+			common.SlugNIC,
+			"",
+			"not supported",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &x12{model: tc.model}
+
+			params, err := client.redfishParameters(tc.component, "/redfish/v1/Systems/1/Bios")
+			if tc.errorContains != "" {
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, constants.OnStartUpdateRequest, params.OperationApplyTime)
+			assert.Equal(t, []string{"/redfish/v1/Systems/1/Bios"}, params.Targets)
+			assert.True(t, json.Valid(params.Oem))
+			assert.Equal(t, tc.expectOem, string(params.Oem))
+		})
+	}
+}
+
+func TestX13FirmwareTaskStatus(t *testing.T) {
+	testcases := []struct {
+		name        string
+		component   string
+		expectState constants.TaskState
+	}{
+		{
+			"bios install requires a host power cycle",
+			common.SlugBIOS,
+			constants.PowerCycleHost,
+		},
+		{
+			"bmc install complete",
+			common.SlugBMC,
+			constants.Complete,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, closeFn := x13Server(t)
+			defer closeFn()
+
+			state, status, err := client.firmwareTaskStatus(context.Background(), tc.component, "1")
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectState, state)
+			assert.Contains(t, status, "id: 1")
+		})
+	}
+}
+
+func TestX13BootProgress(t *testing.T) {
+	client, closeFn := x13Server(t)
+	defer closeFn()
+
+	bp, err := client.getBootProgress()
+	assert.Nil(t, err)
+	assert.Equal(t, "OSRunning", string(bp.LastState))
+
+	complete, err := client.bootComplete()
+	assert.Nil(t, err)
+	assert.True(t, complete)
+}
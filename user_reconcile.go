@@ -0,0 +1,271 @@
+package bmclib
+
+import (
+	"context"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// defaultProtectedUserIDs are the account slots never modified by ReconcileUsers,
+// slot 1 is the reserved anonymous account on IPMI and Dell iDRACs.
+var defaultProtectedUserIDs = []string{"1"}
+
+// UserSpec is a desired BMC user account.
+type UserSpec struct {
+	Username string
+	// Password is required to create the account,
+	// BMCs do not return passwords and so an existing account password is only set when RotatePassword is true.
+	Password string
+	// Role is compared with the account role normalized across providers, see bmc.NormalizeUserRole.
+	Role string
+	// RotatePassword sets the Password on an existing account.
+	RotatePassword bool
+}
+
+// ReconcileUsersOptions are the parameters for ReconcileUsers.
+type ReconcileUsersOptions struct {
+	// DryRun returns the planned changes without applying them.
+	DryRun bool
+	// Prune deletes the accounts that are not in the desired users.
+	Prune bool
+	// ProtectedIDs are the account IDs never updated or deleted, defaults to ID 1.
+	ProtectedIDs []string
+	// ProtectedUsernames are the usernames never updated or deleted,
+	// the username the client is connected with is always protected.
+	ProtectedUsernames []string
+}
+
+// UserAction is a change ReconcileUsers makes to a user account.
+type UserAction string
+
+const (
+	UserActionCreate         UserAction = "create"
+	UserActionUpdateRole     UserAction = "update-role"
+	UserActionRotatePassword UserAction = "rotate-password"
+	UserActionDelete         UserAction = "delete"
+)
+
+// UserChange is a planned or applied change to a user account.
+type UserChange struct {
+	Action   UserAction
+	Username string
+	// ID is the account ID, this is not set for accounts to be created.
+	ID          string
+	Role        string
+	CurrentRole string
+	// Applied is set once the change was applied successfully.
+	Applied bool
+	// Error is the error returned when applying the change.
+	Error error
+}
+
+// UserReconcileReport is the result of ReconcileUsers.
+type UserReconcileReport struct {
+	DryRun bool
+	// Changes are the planned changes, in the order they are applied.
+	Changes []UserChange
+	// Protected are the usernames of the existing accounts that were left untouched since they are protected.
+	Protected []string
+	// Unchanged are the usernames of the desired accounts that required no change.
+	Unchanged []string
+}
+
+func validateUserSpecs(desired []UserSpec) error {
+	seen := map[string]bool{}
+
+	for _, spec := range desired {
+		if spec.Username == "" || spec.Role == "" {
+			return errors.Wrap(bmclibErrs.ErrUserParamsRequired, "username and role are required")
+		}
+
+		if seen[spec.Username] {
+			return errors.Wrap(bmclibErrs.ErrUserReconcile, "duplicate username: "+spec.Username)
+		}
+
+		seen[spec.Username] = true
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// planUserChanges returns the changes to reconcile the current accounts with the desired accounts,
+// creates are listed first and deletes last so an account is never removed before its replacement exists.
+func planUserChanges(current []bmc.User, desired []UserSpec, opts ReconcileUsersOptions) (*UserReconcileReport, error) {
+	report := &UserReconcileReport{DryRun: opts.DryRun}
+
+	protected := func(account bmc.User) bool {
+		return contains(opts.ProtectedIDs, account.ID) || contains(opts.ProtectedUsernames, account.Username)
+	}
+
+	existing := map[string]bmc.User{}
+	for _, account := range current {
+		existing[account.Username] = account
+	}
+
+	var creates, updates, deletes []UserChange
+
+	for _, spec := range desired {
+		account, exists := existing[spec.Username]
+		if !exists {
+			if spec.Password == "" {
+				return nil, errors.Wrap(bmclibErrs.ErrUserParamsRequired, "password required to create user: "+spec.Username)
+			}
+
+			creates = append(creates, UserChange{Action: UserActionCreate, Username: spec.Username, Role: spec.Role})
+
+			continue
+		}
+
+		if protected(account) {
+			report.Protected = append(report.Protected, account.Username)
+			continue
+		}
+
+		if spec.RotatePassword && spec.Password == "" {
+			return nil, errors.Wrap(bmclibErrs.ErrUserParamsRequired, "password required to rotate user password: "+spec.Username)
+		}
+
+		change := UserChange{Username: spec.Username, ID: account.ID, Role: spec.Role, CurrentRole: account.Role}
+
+		switch {
+		// the role is unknown when the provider does not list it
+		case account.Role != "" && account.Role != bmc.NormalizeUserRole(spec.Role):
+			change.Action = UserActionUpdateRole
+		case spec.RotatePassword:
+			change.Action = UserActionRotatePassword
+		default:
+			report.Unchanged = append(report.Unchanged, spec.Username)
+			continue
+		}
+
+		updates = append(updates, change)
+	}
+
+	if opts.Prune {
+		for _, account := range current {
+			if contains(usernames(desired), account.Username) {
+				continue
+			}
+
+			if protected(account) {
+				report.Protected = append(report.Protected, account.Username)
+				continue
+			}
+
+			deletes = append(deletes, UserChange{Action: UserActionDelete, Username: account.Username, ID: account.ID, CurrentRole: account.Role})
+		}
+	}
+
+	report.Changes = append(append(creates, updates...), deletes...)
+
+	return report, nil
+}
+
+func usernames(specs []UserSpec) []string {
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Username)
+	}
+
+	return names
+}
+
+// ReconcileUsers reads the BMC user accounts and creates, updates and deletes accounts to match the desired accounts.
+//
+// Accounts in the protected IDs and usernames, and the account the client is connected with, are left untouched.
+// The changes are applied in order, a failed change does not prevent the remaining changes from being applied,
+// the returned report includes the result of each change and the error includes all failures.
+func (c *Client) ReconcileUsers(ctx context.Context, desired []UserSpec, opts ReconcileUsersOptions) (*UserReconcileReport, error) {
+	ctx, span := c.traceprovider.Tracer(pkgName).Start(ctx, "ReconcileUsers")
+	defer span.End()
+
+	if err := validateUserSpecs(desired); err != nil {
+		return nil, err
+	}
+
+	if opts.ProtectedIDs == nil {
+		opts.ProtectedIDs = defaultProtectedUserIDs
+	}
+
+	if c.Auth.User != "" {
+		opts.ProtectedUsernames = append(opts.ProtectedUsernames, c.Auth.User)
+	}
+
+	users, err := c.Users(ctx)
+	if err != nil {
+		return nil, errors.Wrap(bmclibErrs.ErrUserReconcile, err.Error())
+	}
+
+	report, err := planUserChanges(users, desired, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Bool("ReconcileUsers.DryRun", opts.DryRun),
+		attribute.Int("ReconcileUsers.Changes", len(report.Changes)),
+	)
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	passwords, rotate := map[string]string{}, map[string]bool{}
+	for _, spec := range desired {
+		passwords[spec.Username] = spec.Password
+		rotate[spec.Username] = spec.RotatePassword
+	}
+
+	var errs error
+
+	for idx := range report.Changes {
+		change := &report.Changes[idx]
+
+		var ok bool
+
+		switch change.Action {
+		case UserActionCreate:
+			ok, change.Error = c.CreateUser(ctx, change.Username, passwords[change.Username], change.Role)
+		case UserActionUpdateRole:
+			// the password of an existing account is only changed when rotation is requested,
+			// an empty password leaves it unchanged
+			var pass string
+			if rotate[change.Username] {
+				pass = passwords[change.Username]
+			}
+
+			ok, change.Error = c.UpdateUser(ctx, change.Username, pass, change.Role)
+		case UserActionRotatePassword:
+			ok, change.Error = c.UpdateUser(ctx, change.Username, passwords[change.Username], change.Role)
+		case UserActionDelete:
+			ok, change.Error = c.DeleteUser(ctx, change.Username)
+		}
+
+		if change.Error == nil && !ok {
+			change.Error = errors.Wrap(bmclibErrs.ErrUserReconcile, string(change.Action)+" returned not ok")
+		}
+
+		if change.Error != nil {
+			errs = multierror.Append(errs, errors.WithMessagef(change.Error, "%s user %s", change.Action, change.Username))
+			continue
+		}
+
+		change.Applied = true
+	}
+
+	return report, errs
+}
@@ -0,0 +1,174 @@
+package bmclib
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bmc-toolbox/bmclib/v2/bmc"
+	bmclibErrs "github.com/bmc-toolbox/bmclib/v2/errors"
+	"github.com/jacobweinstock/registrar"
+	"github.com/stretchr/testify/assert"
+)
+
+// userTestProvider records the user account changes made by ReconcileUsers.
+type userTestProvider struct {
+	users    []bmc.User
+	changes  []string
+	failUser string
+}
+
+func (u *userTestProvider) Name() string {
+	return "usertester"
+}
+
+func (u *userTestProvider) Users(ctx context.Context) ([]bmc.User, error) {
+	return u.users, nil
+}
+
+func (u *userTestProvider) UserCreate(ctx context.Context, user, pass, role string) (bool, error) {
+	return u.record("create", user, role)
+}
+
+func (u *userTestProvider) UserUpdate(ctx context.Context, user, pass, role string) (bool, error) {
+	return u.record("update", user, role+":"+pass)
+}
+
+func (u *userTestProvider) UserDelete(ctx context.Context, user string) (bool, error) {
+	return u.record("delete", user, "")
+}
+
+func (u *userTestProvider) record(action, user, detail string) (bool, error) {
+	if user == u.failUser {
+		return false, errors.New("boom")
+	}
+
+	u.changes = append(u.changes, action+" "+user+" "+detail)
+
+	return true, nil
+}
+
+func TestReconcileUsers(t *testing.T) {
+	current := []bmc.User{
+		{ID: "1", Username: "anonymous", Role: bmc.UserRoleNone},
+		{ID: "2", Username: "root", Role: bmc.UserRoleAdministrator, Enabled: true},
+		{ID: "3", Username: "ops", Role: bmc.UserRoleReadOnly, Enabled: true},
+		{ID: "4", Username: "stale", Role: bmc.UserRoleOperator, Enabled: true},
+		{ID: "5", Username: "monitor", Role: bmc.UserRoleReadOnly, Enabled: true},
+	}
+
+	desired := []UserSpec{
+		{Username: "ops", Role: "Operator", Password: "ops-pass"},
+		{Username: "monitor", Role: "readonly"},
+		{Username: "deploy", Role: "Administrator", Password: "deploy-pass"},
+		{Username: "root", Role: "Administrator", Password: "new", RotatePassword: true},
+	}
+
+	testCases := []struct {
+		name            string
+		desired         []UserSpec
+		opts            ReconcileUsersOptions
+		failUser        string
+		wantChanges     []UserChange
+		wantApplied     []string
+		wantProtected   []string
+		wantUnchanged   []string
+		wantErr         error
+		wantErrContains string
+	}{
+		{
+			name:    "dry run",
+			desired: desired,
+			opts:    ReconcileUsersOptions{DryRun: true, Prune: true},
+			wantChanges: []UserChange{
+				{Action: UserActionCreate, Username: "deploy", Role: "Administrator"},
+				{Action: UserActionUpdateRole, Username: "ops", ID: "3", Role: "Operator", CurrentRole: "ReadOnly"},
+				{Action: UserActionRotatePassword, Username: "root", ID: "2", Role: "Administrator", CurrentRole: "Administrator"},
+				{Action: UserActionDelete, Username: "stale", ID: "4", CurrentRole: "Operator"},
+			},
+			wantProtected: []string{"anonymous"},
+			wantUnchanged: []string{"monitor"},
+		},
+		{
+			name:    "apply",
+			desired: desired,
+			opts:    ReconcileUsersOptions{Prune: true},
+			wantApplied: []string{
+				"create deploy Administrator",
+				"update ops Operator:",
+				"update root Administrator:new",
+				"delete stale ",
+			},
+			wantProtected: []string{"anonymous"},
+			wantUnchanged: []string{"monitor"},
+		},
+		{
+			name:          "protected username",
+			desired:       desired[:1],
+			opts:          ReconcileUsersOptions{Prune: true, ProtectedUsernames: []string{"ops", "root"}},
+			wantApplied:   []string{"delete stale ", "delete monitor "},
+			wantProtected: []string{"ops", "anonymous", "root"},
+		},
+		{
+			name:            "failed change continues",
+			desired:         desired[:3],
+			opts:            ReconcileUsersOptions{},
+			failUser:        "deploy",
+			wantApplied:     []string{"update ops Operator:"},
+			wantUnchanged:   []string{"monitor"},
+			wantErrContains: "create user deploy",
+		},
+		{
+			name:        "role update with password rotation",
+			desired:     []UserSpec{{Username: "ops", Role: "Operator", Password: "ops-pass", RotatePassword: true}},
+			wantApplied: []string{"update ops Operator:ops-pass"},
+		},
+		{
+			name:    "rotate without password",
+			desired: []UserSpec{{Username: "ops", Role: "Operator", RotatePassword: true}},
+			wantErr: bmclibErrs.ErrUserParamsRequired,
+		},
+		{
+			name:    "create without password",
+			desired: []UserSpec{{Username: "new", Role: "Operator"}},
+			wantErr: bmclibErrs.ErrUserParamsRequired,
+		},
+		{
+			name:    "duplicate username",
+			desired: []UserSpec{desired[0], desired[0]},
+			wantErr: bmclibErrs.ErrUserReconcile,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &userTestProvider{users: current, failUser: tc.failUser}
+			registry := registrar.NewRegistry()
+			registry.Register(provider.Name(), "test", nil, nil, provider)
+			cl := NewClient("", "", "", WithRegistry(registry))
+
+			report, err := cl.ReconcileUsers(context.Background(), tc.desired, tc.opts)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			if tc.wantErrContains != "" {
+				assert.ErrorContains(t, err, tc.wantErrContains)
+				assert.False(t, report.Changes[0].Applied)
+				assert.True(t, report.Changes[1].Applied)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if tc.wantChanges != nil {
+				assert.True(t, report.DryRun)
+				assert.Equal(t, tc.wantChanges, report.Changes)
+			}
+
+			assert.Equal(t, tc.wantApplied, provider.changes)
+			assert.Equal(t, tc.wantProtected, report.Protected)
+			assert.Equal(t, tc.wantUnchanged, report.Unchanged)
+		})
+	}
+}